@@ -1,5 +1,7 @@
 package tree_sitter_sysml
 
+//go:generate go run gen_node_types.go
+
 // #cgo CFLAGS: -std=c11 -fPIC
 // #include "../../src/parser.c"
 // // NOTE: if your language has an external scanner, add it here.
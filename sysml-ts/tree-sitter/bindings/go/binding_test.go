@@ -1,6 +1,10 @@
 package tree_sitter_sysml_test
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	tree_sitter "github.com/smacker/go-tree-sitter"
@@ -13,3 +17,94 @@ func TestCanLoadGrammar(t *testing.T) {
 		t.Errorf("Error loading Sysml grammar")
 	}
 }
+
+func TestParse(t *testing.T) {
+	tree, err := tree_sitter_sysml.Parse(context.Background(), []byte("part def Engine;"))
+	if err != nil {
+		t.Fatalf("Parse returned an error: %v", err)
+	}
+	if root := tree.RootNode(); root.Type() != "source_file" {
+		t.Errorf("root node type = %q, want %q", root.Type(), "source_file")
+	}
+}
+
+const lineEndingSource = `package Vehicles {
+  // Engines turn fuel into torque.
+  part def Engine {
+    doc /* The main
+           engine. */
+    attribute mass : Real = 1200 [kg];
+  }
+  part engine : Engine; // the only one
+}
+`
+
+// bom is the UTF-8 encoding of U+FEFF that some editors write at the start
+// of a file.
+const bom = "\ufeff"
+
+// lineEndingLayout lists every named node of tree with its text, with CRLF
+// line breaks read as LF, and reports nodes whose range starts or ends on a
+// carriage return or a byte order mark.
+func lineEndingLayout(t *testing.T, tree *tree_sitter.Tree, src []byte) string {
+	t.Helper()
+	var b strings.Builder
+	tree_sitter_sysml.Walk(tree.RootNode(), func(n *tree_sitter.Node) bool {
+		text := n.Content(src)
+		for _, edge := range []string{"\r", bom} {
+			if strings.HasPrefix(text, edge) || strings.HasSuffix(text, edge) {
+				t.Errorf("%s %q includes %q at its edge", n.Type(), text, edge)
+			}
+		}
+		fmt.Fprintf(&b, "%s %q\n", n.Type(), strings.ReplaceAll(text, "\r\n", "\n"))
+		return true
+	})
+	return b.String()
+}
+
+func TestParseCRLFAndBOM(t *testing.T) {
+	lines := strings.SplitAfter(lineEndingSource, "\n")
+	var mixed strings.Builder
+	for i, line := range lines {
+		if i%2 == 0 {
+			line = strings.Replace(line, "\n", "\r\n", 1)
+		}
+		mixed.WriteString(line)
+	}
+	crlf := strings.ReplaceAll(lineEndingSource, "\n", "\r\n")
+
+	tests := []struct {
+		name    string
+		lf, src string
+	}{
+		{"crlf", lineEndingSource, crlf},
+		{"bom and crlf", lineEndingSource, bom + crlf},
+		{"mixed", lineEndingSource, mixed.String()},
+		{"bom only", "package P;", bom + "package P;"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tree_sitter_sysml.Parse(context.Background(), []byte(tt.lf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			src := []byte(tt.src)
+			tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tree.RootNode().HasError() {
+				t.Fatalf("parse has errors: %s", tree.RootNode())
+			}
+			if got, want := tree.RootNode().String(), want.RootNode().String(); got != want {
+				t.Errorf("tree:\n%s\nwant the LF tree:\n%s", got, want)
+			}
+			if got, want := lineEndingLayout(t, tree, src), lineEndingLayout(t, want, []byte(tt.lf)); got != want {
+				t.Errorf("node texts:\n%s\nwant:\n%s", got, want)
+			}
+			if first := tree.RootNode().NamedChild(0); bytes.HasPrefix(src, []byte(bom)) && first.StartByte() != uint32(len(bom)) {
+				t.Errorf("first member starts at byte %d, want %d", first.StartByte(), len(bom))
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package tree_sitter_sysml
+
+import (
+	"sort"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Diagnostic is a problem the parser recovered from.
+type Diagnostic struct {
+	Range Range
+	Start sitter.Point
+	End   sitter.Point
+	// Missing is true when the parser inserted a token that is absent from
+	// the source, and false for text it could not parse.
+	Missing bool
+	Message string
+}
+
+// Diagnostics returns a diagnostic for every ERROR node and every missing
+// token in tree, ordered by start position. ERROR nodes nested inside other
+// ERROR nodes are reported as well. A missing token has an empty range at the
+// point where it was expected.
+func Diagnostics(tree *sitter.Tree) []Diagnostic {
+	var diags []Diagnostic
+	collectDiagnostics(tree.RootNode(), &diags)
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Range.Start < diags[j].Range.Start
+	})
+	return diags
+}
+
+func collectDiagnostics(n *sitter.Node, diags *[]Diagnostic) {
+	switch {
+	case n.IsMissing():
+		*diags = append(*diags, newDiagnostic(n, true, "missing "+quoteNodeType(n)))
+		return
+	case n.IsError():
+		*diags = append(*diags, newDiagnostic(n, false, "syntax error"))
+	case !n.HasError():
+		return
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		collectDiagnostics(n.Child(i), diags)
+	}
+}
+
+func newDiagnostic(n *sitter.Node, missing bool, message string) Diagnostic {
+	return Diagnostic{
+		Range:   Range{Start: n.StartByte(), End: n.EndByte()},
+		Start:   n.StartPoint(),
+		End:     n.EndPoint(),
+		Missing: missing,
+		Message: message,
+	}
+}
+
+// quoteNodeType renders a token as it appears in the source, and a named
+// node by its type.
+func quoteNodeType(n *sitter.Node) string {
+	if n.IsNamed() {
+		return n.Type()
+	}
+	return `"` + n.Type() + `"`
+}
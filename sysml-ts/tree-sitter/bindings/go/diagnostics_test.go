@@ -0,0 +1,71 @@
+package tree_sitter_sysml_test
+
+import (
+	"context"
+	"testing"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+func TestDiagnostics(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    []tree_sitter_sysml.Diagnostic
+	}{
+		{"missing_semicolon.sysml", []tree_sitter_sysml.Diagnostic{{
+			Range:   tree_sitter_sysml.Range{Start: 37, End: 37},
+			Start:   tree_sitter.Point{Row: 1, Column: 17},
+			End:     tree_sitter.Point{Row: 1, Column: 17},
+			Missing: true,
+			Message: `missing ";"`,
+		}}},
+		{"unexpected_token.sysml", []tree_sitter_sysml.Diagnostic{{
+			Range:   tree_sitter_sysml.Range{Start: 64, End: 65},
+			Start:   tree_sitter.Point{Row: 2, Column: 21},
+			End:     tree_sitter.Point{Row: 2, Column: 22},
+			Message: "syntax error",
+		}}},
+	}
+	for _, tt := range tests {
+		tree, _ := parseFixture(t, tt.fixture)
+		got := tree_sitter_sysml.Diagnostics(tree)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %d diagnostics %+v, want %d", tt.fixture, len(got), got, len(tt.want))
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: diagnostic %d = %+v, want %+v", tt.fixture, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestDiagnosticsOrderedByPosition(t *testing.T) {
+	src := []byte("part def A {\n  123 abc ;\n}\ncalc def B {\n  in x : Real\n  return : Real;\n}\n")
+	tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags := tree_sitter_sysml.Diagnostics(tree)
+	if len(diags) < 2 {
+		t.Fatalf("got %d diagnostics, want an error and a missing token", len(diags))
+	}
+	for i := 1; i < len(diags); i++ {
+		if diags[i].Range.Start < diags[i-1].Range.Start {
+			t.Errorf("diagnostic %d starts at %d, before diagnostic %d at %d",
+				i, diags[i].Range.Start, i-1, diags[i-1].Range.Start)
+		}
+	}
+	if first, last := diags[0], diags[len(diags)-1]; first.Missing || !last.Missing {
+		t.Errorf("diagnostics %+v, want the syntax error first and the missing token last", diags)
+	}
+}
+
+func TestDiagnosticsCleanTree(t *testing.T) {
+	tree, _ := parseFixture(t, "vehicle.sysml")
+	if diags := tree_sitter_sysml.Diagnostics(tree); len(diags) != 0 {
+		t.Errorf("Diagnostics on a valid file = %+v, want none", diags)
+	}
+}
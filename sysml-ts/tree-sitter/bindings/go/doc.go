@@ -0,0 +1,37 @@
+package tree_sitter_sysml
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// DocText returns the body of a documentation comment: the text of its
+// doc_text between the /* and */ delimiters, with the leading whitespace and
+// the `*` that starts each line removed, trailing whitespace trimmed, and
+// blank first and last lines dropped. n may be the documentation node or its
+// doc_text; any other node gives "".
+func DocText(n *sitter.Node, src []byte) string {
+	if n.Type() == NodeDocumentation {
+		n = n.ChildByFieldName("text")
+	}
+	if n == nil || n.Type() != NodeDocText {
+		return ""
+	}
+	body := strings.TrimSuffix(strings.TrimPrefix(n.Content(src), "/*"), "*/")
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " \t")
+		if i > 0 && strings.HasPrefix(line, "*") {
+			line = strings.TrimPrefix(line[1:], " ")
+		}
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,56 @@
+package tree_sitter_sysml_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+func TestDocText(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"single line", "doc /* The main propulsion unit. */", "The main propulsion unit."},
+		{"named", "part def Wheel { doc Note /* Named documentation. */ }", "Named documentation."},
+		// The free-standing doc of test/corpus/documentation.txt.
+		{"multi-line", "doc /*\n   * Vehicle models.\n   */", "Vehicle models."},
+		{"several lines", "doc /* Drives\n * the wheels.\n *\n * Rated in kW.\n */", "Drives\nthe wheels.\n\nRated in kW."},
+		{"no leading stars", "doc /*\n  Drives\n  the wheels.\n*/", "Drives\nthe wheels."},
+		{"crlf", "doc /*\r\n * Vehicle models.\r\n */", "Vehicle models."},
+		{"empty", "doc /**/", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := []byte(tt.src)
+			tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			docs := tree_sitter_sysml.FindAll(tree.RootNode(), tree_sitter_sysml.NodeDocumentation)
+			if len(docs) != 1 {
+				t.Fatalf("found %d documentation nodes, want 1", len(docs))
+			}
+			if got := tree_sitter_sysml.DocText(docs[0], src); got != tt.want {
+				t.Errorf("DocText = %q, want %q", got, tt.want)
+			}
+			text := docs[0].ChildByFieldName("text")
+			if got := tree_sitter_sysml.DocText(text, src); got != tt.want {
+				t.Errorf("DocText of doc_text = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocTextOfOtherNode(t *testing.T) {
+	src := []byte("part def Engine;")
+	tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tree_sitter_sysml.DocText(tree.RootNode().NamedChild(0), src); got != "" {
+		t.Errorf("DocText of a part def = %q, want \"\"", got)
+	}
+}
@@ -0,0 +1,34 @@
+package tree_sitter_sysml
+
+import (
+	"context"
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Edit applies edit to old and reparses newSrc incrementally, reusing the
+// subtrees of old that the edit did not touch.
+//
+// The edit describes how oldSrc became newSrc: the bytes in
+// [StartIndex, OldEndIndex) of oldSrc were replaced by the bytes in
+// [StartIndex, NewEndIndex) of newSrc. Indices are byte offsets, and each
+// Point is the zero-based row and byte column of the matching index, in
+// oldSrc for StartPoint and OldEndPoint and in newSrc for NewEndPoint.
+//
+// old is modified in place and must not be reused for another edit; use the
+// returned tree from then on. An error is returned if the edit does not fit
+// the two sources.
+func Edit(parser *sitter.Parser, old *sitter.Tree, oldSrc, newSrc []byte, edit sitter.EditInput) (*sitter.Tree, error) {
+	if edit.StartIndex > edit.OldEndIndex || int(edit.OldEndIndex) > len(oldSrc) {
+		return nil, fmt.Errorf("edit replaces bytes %d to %d of a %d byte source",
+			edit.StartIndex, edit.OldEndIndex, len(oldSrc))
+	}
+	if edit.StartIndex > edit.NewEndIndex || int(edit.NewEndIndex) > len(newSrc) {
+		return nil, fmt.Errorf("edit inserts bytes %d to %d of a %d byte source",
+			edit.StartIndex, edit.NewEndIndex, len(newSrc))
+	}
+	old.Edit(edit)
+	parser.SetLanguage(language)
+	return parser.ParseCtx(context.Background(), old, newSrc)
+}
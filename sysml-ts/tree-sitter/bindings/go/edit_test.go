@@ -0,0 +1,131 @@
+package tree_sitter_sysml_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"unsafe"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+const editSource = `package Vehicles {
+  part def Engine;
+  part engine : Engine;
+  part def Wheel;
+}
+`
+
+// tsNode mirrors the C TSNode that sitter.Node starts with, followed by the
+// *Tree it keeps, in smacker/go-tree-sitter v0.0.0-20230720070738-0d0a9f78d8f8,
+// the version go.mod pins. Neither exposes the subtree a node wraps, and that
+// version has no Tree.ChangedRanges to observe reuse with instead.
+type tsNode struct {
+	context [4]uint32
+	id      unsafe.Pointer
+	tree    unsafe.Pointer
+}
+
+// nodeID returns the address of the heap-allocated subtree behind n, which is
+// what an incremental parse shares between the old and the new tree. TSNode.id
+// points at the parent's slot holding the subtree pointer. The test is
+// skipped if sitter.Node no longer has the layout nodeID reads.
+func nodeID(t *testing.T, n *tree_sitter.Node) uintptr {
+	t.Helper()
+	if unsafe.Sizeof(*n) != unsafe.Sizeof(tsNode{})+unsafe.Sizeof(uintptr(0)) {
+		t.Skipf("sitter.Node is %d bytes, not a TSNode and a *Tree", unsafe.Sizeof(*n))
+	}
+	return uintptr(*(*unsafe.Pointer)((*tsNode)(unsafe.Pointer(n)).id))
+}
+
+// pointAt returns the row and byte column of offset in src.
+func pointAt(src []byte, offset int) tree_sitter.Point {
+	row := bytes.Count(src[:offset], []byte("\n"))
+	col := offset - (bytes.LastIndexByte(src[:offset], '\n') + 1)
+	return tree_sitter.Point{Row: uint32(row), Column: uint32(col)}
+}
+
+// replace returns src with the first occurrence of old replaced by repl,
+// and the edit describing the change.
+func replace(src []byte, old, repl string) ([]byte, tree_sitter.EditInput) {
+	start := bytes.Index(src, []byte(old))
+	newSrc := append(append(append([]byte{}, src[:start]...), repl...), src[start+len(old):]...)
+	return newSrc, tree_sitter.EditInput{
+		StartIndex:  uint32(start),
+		OldEndIndex: uint32(start + len(old)),
+		NewEndIndex: uint32(start + len(repl)),
+		StartPoint:  pointAt(src, start),
+		OldEndPoint: pointAt(src, start+len(old)),
+		NewEndPoint: pointAt(newSrc, start+len(repl)),
+	}
+}
+
+func TestEditReusesUnchangedSubtrees(t *testing.T) {
+	oldSrc := []byte(editSource)
+	old, err := tree_sitter_sysml.Parse(context.Background(), oldSrc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	members := func(tree *tree_sitter.Tree) []*tree_sitter.Node {
+		block := tree.RootNode().NamedChild(0).ChildByFieldName("name").NextNamedSibling()
+		var nodes []*tree_sitter.Node
+		for i := 0; i < int(block.NamedChildCount()); i++ {
+			nodes = append(nodes, block.NamedChild(i))
+		}
+		return nodes
+	}
+	var oldIDs []uintptr
+	seen := map[uintptr]bool{}
+	for _, n := range members(old) {
+		id := nodeID(t, n)
+		if seen[id] {
+			t.Fatalf("nodeID gives %#x to two members; it does not read the subtree", id)
+		}
+		seen[id] = true
+		oldIDs = append(oldIDs, id)
+	}
+
+	newSrc, edit := replace(oldSrc, "engine :", "motor :")
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	tree, err := tree_sitter_sysml.Edit(parser, old, oldSrc, newSrc, edit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fresh, err := tree_sitter_sysml.Parse(context.Background(), newSrc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tree.RootNode().String(), fresh.RootNode().String(); got != want {
+		t.Fatalf("incremental tree:\n%s\nwant:\n%s", got, want)
+	}
+
+	got := members(tree)
+	if len(got) != len(oldIDs) {
+		t.Fatalf("got %d members after the edit, want %d", len(got), len(oldIDs))
+	}
+	if name := got[1].ChildByFieldName("name").Content(newSrc); name != "motor" {
+		t.Errorf("edited member is named %q, want %q", name, "motor")
+	}
+	for i, reused := range []bool{true, false, true} {
+		if same := nodeID(t, got[i]) == oldIDs[i]; same != reused {
+			t.Errorf("member %d (%s) reused = %v, want %v", i, got[i].Content(newSrc), same, reused)
+		}
+	}
+}
+
+func TestEditRejectsEditOutsideSource(t *testing.T) {
+	src := []byte(editSource)
+	old, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+	edit := tree_sitter.EditInput{StartIndex: 10, OldEndIndex: uint32(len(src)) + 1, NewEndIndex: 10}
+	if _, err := tree_sitter_sysml.Edit(parser, old, src, src[:10], edit); err == nil {
+		t.Error("Edit accepted an edit that ends past the old source")
+	}
+}
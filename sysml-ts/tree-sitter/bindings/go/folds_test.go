@@ -0,0 +1,51 @@
+package tree_sitter_sysml_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+const foldsSource = `package Vehicle {
+  /*
+   * Engine of the vehicle.
+   */
+  part def Engine {
+    attribute mass;
+  }
+  part engine;
+  part wheel { }
+}
+`
+
+// foldedRows returns the first row of every multi-line range captured as
+// @fold; editors ignore ranges that start and end on the same row.
+func foldedRows(t *testing.T, src []byte) []uint32 {
+	t.Helper()
+	q := loadQuery(t, "folds.scm")
+	tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rows []uint32
+	for _, c := range captures(t, q, tree.RootNode()) {
+		if c.node.StartPoint().Row < c.node.EndPoint().Row {
+			rows = append(rows, c.node.StartPoint().Row)
+		}
+	}
+	return rows
+}
+
+func TestFolds(t *testing.T) {
+	got := foldedRows(t, []byte(foldsSource))
+	want := map[uint32]bool{0: true, 1: true, 4: true}
+	if len(got) != len(want) {
+		t.Fatalf("folded rows = %v, want the package body, the comment and the part def body", got)
+	}
+	for _, row := range got {
+		if !want[row] {
+			t.Errorf("unexpected fold starting on row %d", row)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package tree_sitter_sysml
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"unicode"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// formatIndent is the indentation added for each level of body nesting.
+const formatIndent = "  "
+
+// ErrSyntax is returned by Format for a tree that contains syntax errors.
+var ErrSyntax = errors.New("source has syntax errors")
+
+// Format re-emits the source of tree, whose source text is src, in canonical
+// layout: each member of a body on its own line, indented one level deeper
+// than the body's header, and single spaces between tokens except around
+// `::`, `.`, `#`, `->`, brackets and separators. Formatting a formatted source
+// returns it unchanged.
+//
+// Comments and doc blocks are kept next to the tokens they follow, a comment
+// that sits on its own line stays on its own line, and a single blank line
+// is kept wherever the source separates members by one or more. The bodies
+// of `->` expressions and of `@` annotations stay on one line. Trees with
+// syntax errors are not formatted; ErrSyntax is returned instead.
+func Format(tree *sitter.Tree, src []byte) ([]byte, error) {
+	root := tree.RootNode()
+	if root.HasError() {
+		return nil, ErrSyntax
+	}
+	f := &formatter{src: src}
+	f.node(root)
+	if f.out.Len() > 0 {
+		f.out.WriteByte('\n')
+	}
+	return f.out.Bytes(), nil
+}
+
+type formatter struct {
+	src   []byte
+	out   bytes.Buffer
+	depth int
+	// prev is the token written last, or nil at the start of the output.
+	prev *sitter.Node
+	// newline requests a line break before the next token.
+	newline bool
+}
+
+func (f *formatter) node(n *sitter.Node) {
+	if n.ChildCount() == 0 {
+		f.token(n)
+		return
+	}
+	for i := 0; i < int(n.ChildCount()); i++ {
+		f.node(n.Child(i))
+	}
+}
+
+func (f *formatter) token(n *sitter.Node) {
+	body := n.Parent()
+	opens := n.Type() == "{" && expands(body)
+	closes := n.Type() == "}" && expands(body)
+	if closes {
+		f.depth--
+		if f.prev != nil && f.prev.Type() == "{" && f.prev.Parent().Equal(body) {
+			f.newline = false
+		} else {
+			f.newline = true
+		}
+	}
+	if f.startsLine(n) {
+		f.newline = true
+	}
+	// A comment on the same line as the token before it stays there, even
+	// where a line break is due; the break then follows the comment.
+	pending := false
+	if n.IsExtra() && f.prev != nil && n.StartPoint().Row == f.prev.EndPoint().Row {
+		pending, f.newline = f.newline, false
+	}
+
+	switch {
+	case f.prev == nil:
+	case f.newline:
+		f.out.WriteByte('\n')
+		if f.blankLineBefore(n) && !closes && f.prev.Type() != "{" {
+			f.out.WriteByte('\n')
+		}
+		f.out.WriteString(strings.Repeat(formatIndent, f.depth))
+	case spaced(f.prev, n):
+		f.out.WriteByte(' ')
+	}
+	f.out.WriteString(n.Content(f.src))
+	f.prev = n
+	f.newline = pending
+
+	switch {
+	case opens:
+		f.depth++
+		f.newline = true
+	case n.Type() == "line_comment":
+		f.newline = true
+	case n.Type() == "block_comment" && f.nextOnLaterRow(n):
+		f.newline = true
+	case n.Type() == NodeDocText && !isMemberList(body.Parent()):
+		// A doc prefix stays on the line above the member it documents.
+		f.newline = true
+	}
+}
+
+// startsLine reports whether n must begin a line: it is the first token of a
+// member of a body, or a comment that is not on the same line as the token
+// before it.
+func (f *formatter) startsLine(n *sitter.Node) bool {
+	if f.prev == nil {
+		return false
+	}
+	if n.IsExtra() {
+		return n.StartPoint().Row > f.prev.EndPoint().Row
+	}
+	if n.Type() == "{" || n.Type() == "}" {
+		return false
+	}
+	member := n
+	for {
+		parent := member.Parent()
+		if parent == nil {
+			return false
+		}
+		if isMemberList(parent) {
+			return true
+		}
+		if parent.StartByte() != n.StartByte() {
+			return false
+		}
+		member = parent
+	}
+}
+
+// blankLineBefore reports whether the source separates n from the token
+// before it by at least one empty line.
+func (f *formatter) blankLineBefore(n *sitter.Node) bool {
+	gap := f.src[f.prev.EndByte():n.StartByte()]
+	return bytes.Count(gap, []byte("\n")) > 1
+}
+
+// nextOnLaterRow reports whether the source continues on a later line after
+// n, or ends.
+func (f *formatter) nextOnLaterRow(n *sitter.Node) bool {
+	rest := f.src[n.EndByte():]
+	i := bytes.IndexFunc(rest, func(r rune) bool { return !unicode.IsSpace(r) })
+	return i < 0 || bytes.IndexByte(rest[:i], '\n') >= 0
+}
+
+// isMemberList reports whether the named children of n are members laid out
+// one per line.
+func isMemberList(n *sitter.Node) bool {
+	return n != nil && (n.Type() == NodeSourceFile || expands(n))
+}
+
+// expands reports whether n is a body written across several lines. The
+// bodies of `->` expressions and `@` annotations are kept on one line.
+func expands(n *sitter.Node) bool {
+	if n == nil || n.ChildCount() == 0 || n.Child(0).Type() != "{" {
+		return false
+	}
+	switch n.Type() {
+	case NodeBodyExpression:
+		return false
+	case NodeMetadataBody:
+		return n.Parent().Type() != NodeAnnotation
+	}
+	return true
+}
+
+// spaced reports whether a space separates the tokens prev and next when
+// they share a line.
+func spaced(prev, next *sitter.Node) bool {
+	if prev.Type() == "{" && next.Type() == "}" {
+		return false
+	}
+	switch prev.Type() {
+	case "(", "[", "::", ".", "#", "->", "..", "~":
+		return false
+	case "@":
+		return prev.Parent().Type() != NodeAnnotation && prev.Parent().Type() != NodeImportFilter
+	}
+	if prev.Parent().Type() == NodeUnaryExpression && isSymbol(prev) {
+		return false
+	}
+	switch next.Type() {
+	case ")", "]", ",", ";", "::", ".", "#", "->", "..", NodeImportWildcard, NodeImportRecursive:
+		return false
+	case "(":
+		return next.Parent().Type() != NodeArgumentList
+	case "[":
+		return next.Parent().Type() != NodeImportFilter
+	}
+	return true
+}
+
+// isSymbol reports whether the operator token n is punctuation rather than a
+// word such as `not`.
+func isSymbol(n *sitter.Node) bool {
+	for _, r := range n.Type() {
+		if unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
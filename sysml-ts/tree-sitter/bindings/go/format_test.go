@@ -0,0 +1,59 @@
+package tree_sitter_sysml_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+func format(t *testing.T, src []byte) []byte {
+	t.Helper()
+	tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := tree_sitter_sysml.Format(tree, src)
+	if err != nil {
+		t.Fatalf("Format returned an error: %v", err)
+	}
+	return out
+}
+
+func TestFormat(t *testing.T) {
+	_, src := parseFixture(t, "format_input.sysml")
+	want, err := os.ReadFile("testdata/format_golden.sysml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := format(t, src); string(got) != string(want) {
+		t.Errorf("Format:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatIsIdempotent(t *testing.T) {
+	_, golden := parseFixture(t, "format_golden.sysml")
+	if got := format(t, golden); string(got) != string(golden) {
+		t.Errorf("Format changed formatted source:\n%s", got)
+	}
+}
+
+func TestFormatKeepsTree(t *testing.T) {
+	tree, src := parseFixture(t, "format_input.sysml")
+	formatted, err := tree_sitter_sysml.Parse(context.Background(), format(t, src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := formatted.RootNode().String(), tree.RootNode().String(); got != want {
+		t.Errorf("formatted tree:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatRejectsSyntaxErrors(t *testing.T) {
+	tree, src := parseFixture(t, "unexpected_token.sysml")
+	if _, err := tree_sitter_sysml.Format(tree, src); !errors.Is(err, tree_sitter_sysml.ErrSyntax) {
+		t.Errorf("Format error = %v, want %v", err, tree_sitter_sysml.ErrSyntax)
+	}
+}
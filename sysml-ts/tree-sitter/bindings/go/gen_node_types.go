@@ -0,0 +1,90 @@
+//go:build ignore
+
+// gen_node_types writes node_types.go from the grammar's node-types.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+type nodeType struct {
+	Type  string `json:"type"`
+	Named bool   `json:"named"`
+}
+
+// constName turns a node type such as "part_def" into "NodePartDef", or
+// "KindPartDef" with the "Kind" prefix.
+func constName(prefix, nodeType string) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	for _, part := range strings.Split(nodeType, "_") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+func main() {
+	data, err := os.ReadFile("../../src/node-types.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	var types []nodeType
+	if err := json.Unmarshal(data, &types); err != nil {
+		log.Fatal(err)
+	}
+	var named []string
+	for _, t := range types {
+		if t.Named {
+			named = append(named, t.Type)
+		}
+	}
+	sort.Strings(named)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_node_types.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package tree_sitter_sysml\n\n")
+	buf.WriteString("// Named node types produced by the SysML grammar.\n")
+	buf.WriteString("const (\n")
+	for _, t := range named {
+		fmt.Fprintf(&buf, "\t%s = %q\n", constName("Node", t), t)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// NamedNodeTypes lists every named node type in node-types.json.\n")
+	buf.WriteString("var NamedNodeTypes = []string{\n")
+	for _, t := range named {
+		fmt.Fprintf(&buf, "\t%s,\n", constName("Node", t))
+	}
+	buf.WriteString("}\n\n")
+	buf.WriteString("// Kinds of the named node types, in the order of NamedNodeTypes.\n")
+	buf.WriteString("const (\n")
+	for i, t := range named {
+		if i == 0 {
+			fmt.Fprintf(&buf, "\t%s NodeKind = iota + 1\n", constName("Kind", t))
+		} else {
+			fmt.Fprintf(&buf, "\t%s\n", constName("Kind", t))
+		}
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("var nodeKinds = map[string]NodeKind{\n")
+	for _, t := range named {
+		fmt.Fprintf(&buf, "\t%s: %s,\n", constName("Node", t), constName("Kind", t))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("node_types.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,28 @@
+package tree_sitter_sysml_test
+
+import (
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+// TestHighlightsCaptureNameSegmentsOnce checks that no segment of a
+// qualified name gets two highlight captures. Editors disagree on which of
+// two overlapping captures wins, so a feature reference that also matched the
+// generic type pattern would show as a type in some of them.
+func TestHighlightsCaptureNameSegmentsOnce(t *testing.T) {
+	tree, src := parseFixture(t, "queries.sysml")
+	q := loadQuery(t, "highlights.scm")
+	seen := map[[2]uint32]string{}
+	for _, c := range captures(t, q, tree.RootNode()) {
+		if c.node.Parent().Type() != tree_sitter_sysml.NodeQualifiedName {
+			continue
+		}
+		key := [2]uint32{c.node.StartByte(), c.node.EndByte()}
+		if prev, ok := seen[key]; ok && prev != c.name {
+			t.Errorf("%q at %d is captured as both @%s and @%s",
+				c.node.Content(src), c.node.StartByte(), prev, c.name)
+		}
+		seen[key] = c.name
+	}
+}
@@ -0,0 +1,55 @@
+package tree_sitter_sysml_test
+
+import (
+	"strings"
+	"testing"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+)
+
+func leadingSpaces(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// indentLevels applies the Helix rules to every line: each @indent node that
+// started on an earlier line and is still open adds a level, and a line that
+// begins with an @outdent token gives one back.
+func indentLevels(t *testing.T, tree *tree_sitter.Tree, src []byte) []int {
+	t.Helper()
+	var indents, outdents []*tree_sitter.Node
+	for _, c := range captures(t, loadQuery(t, "indents.scm"), tree.RootNode()) {
+		switch c.name {
+		case "indent":
+			indents = append(indents, c.node)
+		case "outdent":
+			outdents = append(outdents, c.node)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(src), "\n"), "\n")
+	levels := make([]int, len(lines))
+	for row := range lines {
+		for _, n := range indents {
+			if n.StartPoint().Row < uint32(row) && uint32(row) <= n.EndPoint().Row {
+				levels[row]++
+			}
+		}
+		for _, n := range outdents {
+			if n.StartPoint().Row == uint32(row) && int(n.StartPoint().Column) == leadingSpaces(lines[row]) {
+				levels[row]--
+			}
+		}
+	}
+	return levels
+}
+
+func TestIndentsNestCumulatively(t *testing.T) {
+	tree, src := parseFixture(t, "indents.sysml")
+	lines := strings.Split(strings.TrimSuffix(string(src), "\n"), "\n")
+	for row, level := range indentLevels(t, tree, src) {
+		want := leadingSpaces(lines[row]) / 2
+		if level != want {
+			t.Errorf("line %d %q: indent level %d, want %d", row+1, lines[row], level, want)
+		}
+	}
+}
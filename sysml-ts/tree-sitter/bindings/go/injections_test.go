@@ -0,0 +1,54 @@
+package tree_sitter_sysml_test
+
+import (
+	"context"
+	"testing"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+const injectionsSource = `// A *line* comment is not markdown.
+/* Neither is a **block** comment. */
+part def Engine {
+  doc /*
+   * # Engine
+   * Converts *fuel* into torque.
+   */
+}
+`
+
+func TestInjectionsTargetDocumentationOnly(t *testing.T) {
+	q := loadQuery(t, "injections.scm")
+	src := []byte(injectionsSource)
+	tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var injected []*tree_sitter.Node
+	for _, c := range captures(t, q, tree.RootNode()) {
+		if c.name != "injection.content" {
+			continue
+		}
+		injected = append(injected, c.node)
+		var language string
+		// Each predicate is its steps followed by a terminating "done" step.
+		for _, steps := range q.PredicatesForPattern(c.pattern) {
+			if len(steps) == 4 && q.StringValueForId(steps[0].ValueId) == "set!" &&
+				q.StringValueForId(steps[1].ValueId) == "injection.language" {
+				language = q.StringValueForId(steps[2].ValueId)
+			}
+		}
+		if language != "markdown" {
+			t.Errorf("injection.language = %q, want %q", language, "markdown")
+		}
+	}
+
+	if len(injected) != 1 {
+		t.Fatalf("got %d injections, want 1", len(injected))
+	}
+	if got := injected[0].Type(); got != tree_sitter_sysml.NodeDocText {
+		t.Errorf("injected node type = %q, want %q", got, tree_sitter_sysml.NodeDocText)
+	}
+}
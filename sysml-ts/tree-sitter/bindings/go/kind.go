@@ -0,0 +1,28 @@
+package tree_sitter_sysml
+
+import sitter "github.com/smacker/go-tree-sitter"
+
+// NodeKind identifies a named node type, so that callers can switch on a
+// small integer rather than compare type strings. The Kind constants are
+// generated alongside the Node constants from node-types.json.
+type NodeKind int
+
+// KindUnknown is the kind of anonymous nodes, ERROR nodes and any type the
+// generated constants do not know about.
+const KindUnknown NodeKind = 0
+
+// KindOf returns the kind of n.
+func KindOf(n *sitter.Node) NodeKind {
+	if !n.IsNamed() {
+		return KindUnknown
+	}
+	return nodeKinds[n.Type()]
+}
+
+// String returns the node type the kind stands for, or "unknown".
+func (k NodeKind) String() string {
+	if k <= KindUnknown || int(k) > len(NamedNodeTypes) {
+		return "unknown"
+	}
+	return NamedNodeTypes[k-1]
+}
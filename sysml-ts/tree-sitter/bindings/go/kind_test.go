@@ -0,0 +1,59 @@
+package tree_sitter_sysml_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+func TestKindOf(t *testing.T) {
+	tree, _ := parseFixture(t, "vehicle.sysml")
+	root := tree.RootNode()
+	pkg := root.NamedChild(0)
+	block := pkg.ChildByFieldName("name").NextNamedSibling()
+
+	tests := []struct {
+		name string
+		got  tree_sitter_sysml.NodeKind
+		want tree_sitter_sysml.NodeKind
+	}{
+		{"root", tree_sitter_sysml.KindOf(root), tree_sitter_sysml.KindSourceFile},
+		{"package", tree_sitter_sysml.KindOf(pkg), tree_sitter_sysml.KindPackageDecl},
+		{"package name", tree_sitter_sysml.KindOf(pkg.ChildByFieldName("name")), tree_sitter_sysml.KindIdentifier},
+		{"package body", tree_sitter_sysml.KindOf(block), tree_sitter_sysml.KindBlock},
+		{"import", tree_sitter_sysml.KindOf(block.NamedChild(0)), tree_sitter_sysml.KindImportStatement},
+		{"part def", tree_sitter_sysml.KindOf(block.NamedChild(1)), tree_sitter_sysml.KindPartDef},
+		{"keyword", tree_sitter_sysml.KindOf(pkg.Child(0)), tree_sitter_sysml.KindUnknown},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("KindOf(%s) = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestKindOfError(t *testing.T) {
+	tree, err := tree_sitter_sysml.Parse(context.Background(), []byte("part def A { 123 abc ; }"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	errors := tree_sitter_sysml.FindAll(tree.RootNode(), "ERROR")
+	if len(errors) == 0 {
+		t.Fatal("no ERROR node in the parse")
+	}
+	if got := tree_sitter_sysml.KindOf(errors[0]); got != tree_sitter_sysml.KindUnknown {
+		t.Errorf("KindOf(ERROR) = %v, want %v", got, tree_sitter_sysml.KindUnknown)
+	}
+}
+
+func TestNodeKindString(t *testing.T) {
+	for i, nt := range tree_sitter_sysml.NamedNodeTypes {
+		if got := tree_sitter_sysml.NodeKind(i + 1).String(); got != nt {
+			t.Errorf("NodeKind(%d).String() = %q, want %q", i+1, got, nt)
+		}
+	}
+	if got := tree_sitter_sysml.KindUnknown.String(); got != "unknown" {
+		t.Errorf("KindUnknown.String() = %q, want %q", got, "unknown")
+	}
+}
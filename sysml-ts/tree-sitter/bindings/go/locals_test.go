@@ -0,0 +1,120 @@
+package tree_sitter_sysml_test
+
+import (
+	"context"
+	"testing"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+const localsSource = `package A {
+  part def Engine;
+  part engine : Engine;
+}
+package B {
+  part def Engine;
+  part engine : Engine;
+}
+`
+
+// resolve returns the definition a reference binds to using the capture
+// semantics of locals.scm: the innermost enclosing scope is searched first,
+// then each outer scope in turn.
+func resolve(ref *tree_sitter.Node, defs []*tree_sitter.Node, scopes []*tree_sitter.Node, src []byte) *tree_sitter.Node {
+	innermost := func(n *tree_sitter.Node) *tree_sitter.Node {
+		var best *tree_sitter.Node
+		for _, s := range scopes {
+			if s.StartByte() <= n.StartByte() && n.EndByte() <= s.EndByte() {
+				if best == nil || s.EndByte()-s.StartByte() < best.EndByte()-best.StartByte() {
+					best = s
+				}
+			}
+		}
+		return best
+	}
+	for scope := innermost(ref); scope != nil; {
+		for _, d := range defs {
+			if innermost(d).Equal(scope) && d.Content(src) == ref.Content(src) {
+				return d
+			}
+		}
+		parent := scope.Parent()
+		if parent == nil {
+			break
+		}
+		scope = innermost(parent)
+	}
+	return nil
+}
+
+func enclosingPackage(n *tree_sitter.Node) *tree_sitter.Node {
+	for n != nil && n.Type() != "package_decl" {
+		n = n.Parent()
+	}
+	return n
+}
+
+// localsCaptures runs locals.scm over src and returns its captures by kind.
+func localsCaptures(t *testing.T, src []byte) (scopes, defs, refs []*tree_sitter.Node) {
+	t.Helper()
+	tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range captures(t, loadQuery(t, "locals.scm"), tree.RootNode()) {
+		switch c.name {
+		case "local.scope":
+			scopes = append(scopes, c.node)
+		case "local.definition":
+			defs = append(defs, c.node)
+		case "local.reference":
+			refs = append(refs, c.node)
+		}
+	}
+	return scopes, defs, refs
+}
+
+func TestLocalsResolveWithinEnclosingScope(t *testing.T) {
+	src := []byte(localsSource)
+	scopes, defs, refs := localsCaptures(t, src)
+
+	if len(refs) != 2 {
+		t.Fatalf("got %d references, want 2", len(refs))
+	}
+	for _, ref := range refs {
+		def := resolve(ref, defs, scopes, src)
+		if def == nil {
+			t.Fatalf("reference %q at byte %d did not resolve", ref.Content(src), ref.StartByte())
+		}
+		if !enclosingPackage(ref).Equal(enclosingPackage(def)) {
+			t.Errorf("reference at byte %d resolved to a definition in another package (byte %d)",
+				ref.StartByte(), def.StartByte())
+		}
+	}
+}
+
+func TestLocalsAliasIsDefinition(t *testing.T) {
+	src := []byte(`package A {
+  alias Car for Vehicles::Automobile;
+  part car : Car;
+}
+`)
+	scopes, defs, refs := localsCaptures(t, src)
+
+	for _, ref := range refs {
+		if ref.Content(src) != "Car" {
+			continue
+		}
+		def := resolve(ref, defs, scopes, src)
+		if def == nil {
+			t.Fatal("reference to the alias did not resolve")
+		}
+		if got := def.Parent().Type(); got != tree_sitter_sysml.NodeAliasMember {
+			t.Errorf("reference resolved to a %s, want the alias", got)
+		}
+		return
+	}
+	t.Fatal("no reference to the alias was captured")
+}
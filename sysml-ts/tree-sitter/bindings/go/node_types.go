@@ -0,0 +1,502 @@
+// Code generated by gen_node_types.go; DO NOT EDIT.
+
+package tree_sitter_sysml
+
+// Named node types produced by the SysML grammar.
+const (
+	NodeActionBody              = "action_body"
+	NodeActionDefinition        = "action_definition"
+	NodeActionUsage             = "action_usage"
+	NodeActorMember             = "actor_member"
+	NodeAliasMember             = "alias_member"
+	NodeAllocationUsage         = "allocation_usage"
+	NodeAnalysisCaseDefinition  = "analysis_case_definition"
+	NodeAnnotation              = "annotation"
+	NodeArgumentList            = "argument_list"
+	NodeArrowExpression         = "arrow_expression"
+	NodeAssertConstraintUsage   = "assert_constraint_usage"
+	NodeAttributeDef            = "attribute_def"
+	NodeAttributeUsage          = "attribute_usage"
+	NodeBinaryExpression        = "binary_expression"
+	NodeBindingConnector        = "binding_connector"
+	NodeBlock                   = "block"
+	NodeBlockComment            = "block_comment"
+	NodeBodyExpression          = "body_expression"
+	NodeBooleanLiteral          = "boolean_literal"
+	NodeCalcBody                = "calc_body"
+	NodeCalcDefinition          = "calc_definition"
+	NodeCalcUsage               = "calc_usage"
+	NodeCaseBody                = "case_body"
+	NodeConditionalExpression   = "conditional_expression"
+	NodeConjugation             = "conjugation"
+	NodeConnectionBody          = "connection_body"
+	NodeConnectionDefinition    = "connection_definition"
+	NodeConnectionUsage         = "connection_usage"
+	NodeConstraintBody          = "constraint_body"
+	NodeConstraintDefinition    = "constraint_definition"
+	NodeConstraintUsage         = "constraint_usage"
+	NodeControlNode             = "control_node"
+	NodeDefaultValue            = "default_value"
+	NodeDefinition              = "definition"
+	NodeDirectedFeature         = "directed_feature"
+	NodeDirection               = "direction"
+	NodeDocText                 = "doc_text"
+	NodeDocumentation           = "documentation"
+	NodeEndMember               = "end_member"
+	NodeEnumerationBody         = "enumeration_body"
+	NodeEnumerationDefinition   = "enumeration_definition"
+	NodeEnumerationLiteral      = "enumeration_literal"
+	NodeExhibitModifier         = "exhibit_modifier"
+	NodeExposeMember            = "expose_member"
+	NodeFeatureChainExpression  = "feature_chain_expression"
+	NodeFeatureValue            = "feature_value"
+	NodeFlowConnectionUsage     = "flow_connection_usage"
+	NodeIdentifier              = "identifier"
+	NodeImportFilter            = "import_filter"
+	NodeImportRecursive         = "import_recursive"
+	NodeImportStatement         = "import_statement"
+	NodeImportWildcard          = "import_wildcard"
+	NodeIncludeUseCase          = "include_use_case"
+	NodeIndexExpression         = "index_expression"
+	NodeInitialValue            = "initial_value"
+	NodeIntegerLiteral          = "integer_literal"
+	NodeInterfaceDefinition     = "interface_definition"
+	NodeInterfaceUsage          = "interface_usage"
+	NodeInvocationExpression    = "invocation_expression"
+	NodeItemDefinition          = "item_definition"
+	NodeItemUsage               = "item_usage"
+	NodeLibraryModifier         = "library_modifier"
+	NodeLineComment             = "line_comment"
+	NodeMetadataAssignment      = "metadata_assignment"
+	NodeMetadataBody            = "metadata_body"
+	NodeMetadataDefinition      = "metadata_definition"
+	NodeMetadataUsage           = "metadata_usage"
+	NodeMultiplicityModifier    = "multiplicity_modifier"
+	NodeMultiplicityRange       = "multiplicity_range"
+	NodeNull                    = "null"
+	NodeObjectiveMember         = "objective_member"
+	NodeOccurrenceDefinition    = "occurrence_definition"
+	NodeOccurrenceUsage         = "occurrence_usage"
+	NodePackageDecl             = "package_decl"
+	NodeParameterMember         = "parameter_member"
+	NodeParenthesizedExpression = "parenthesized_expression"
+	NodePartDef                 = "part_def"
+	NodePartUsage               = "part_usage"
+	NodePerformModifier         = "perform_modifier"
+	NodePortBody                = "port_body"
+	NodePortDefinition          = "port_definition"
+	NodePortUsage               = "port_usage"
+	NodePortionUsage            = "portion_usage"
+	NodeQualifiedName           = "qualified_name"
+	NodeRealLiteral             = "real_literal"
+	NodeRedefinition            = "redefinition"
+	NodeReferenceSubsetting     = "reference_subsetting"
+	NodeRenderMember            = "render_member"
+	NodeRenderingDefinition     = "rendering_definition"
+	NodeRequireConstraintMember = "require_constraint_member"
+	NodeRequirementBody         = "requirement_body"
+	NodeRequirementDefinition   = "requirement_definition"
+	NodeRequirementUsage        = "requirement_usage"
+	NodeReturnMember            = "return_member"
+	NodeSatisfyRequirementUsage = "satisfy_requirement_usage"
+	NodeSelf                    = "self"
+	NodeSourceFile              = "source_file"
+	NodeSpecialization          = "specialization"
+	NodeStandardModifier        = "standard_modifier"
+	NodeStateActionMember       = "state_action_member"
+	NodeStateBody               = "state_body"
+	NodeStateDefinition         = "state_definition"
+	NodeStateUsage              = "state_usage"
+	NodeStringLiteral           = "string_literal"
+	NodeSubjectMember           = "subject_member"
+	NodeSubsetting              = "subsetting"
+	NodeSuccession              = "succession"
+	NodeTransitionUsage         = "transition_usage"
+	NodeTyping                  = "typing"
+	NodeUnaryExpression         = "unary_expression"
+	NodeUnbounded               = "unbounded"
+	NodeUsage                   = "usage"
+	NodeUseCaseDefinition       = "use_case_definition"
+	NodeUseCaseUsage            = "use_case_usage"
+	NodeVerificationDefinition  = "verification_definition"
+	NodeVerificationUsage       = "verification_usage"
+	NodeVerifyMember            = "verify_member"
+	NodeViewBody                = "view_body"
+	NodeViewDefinition          = "view_definition"
+	NodeViewpointDefinition     = "viewpoint_definition"
+	NodeVisibility              = "visibility"
+)
+
+// NamedNodeTypes lists every named node type in node-types.json.
+var NamedNodeTypes = []string{
+	NodeActionBody,
+	NodeActionDefinition,
+	NodeActionUsage,
+	NodeActorMember,
+	NodeAliasMember,
+	NodeAllocationUsage,
+	NodeAnalysisCaseDefinition,
+	NodeAnnotation,
+	NodeArgumentList,
+	NodeArrowExpression,
+	NodeAssertConstraintUsage,
+	NodeAttributeDef,
+	NodeAttributeUsage,
+	NodeBinaryExpression,
+	NodeBindingConnector,
+	NodeBlock,
+	NodeBlockComment,
+	NodeBodyExpression,
+	NodeBooleanLiteral,
+	NodeCalcBody,
+	NodeCalcDefinition,
+	NodeCalcUsage,
+	NodeCaseBody,
+	NodeConditionalExpression,
+	NodeConjugation,
+	NodeConnectionBody,
+	NodeConnectionDefinition,
+	NodeConnectionUsage,
+	NodeConstraintBody,
+	NodeConstraintDefinition,
+	NodeConstraintUsage,
+	NodeControlNode,
+	NodeDefaultValue,
+	NodeDefinition,
+	NodeDirectedFeature,
+	NodeDirection,
+	NodeDocText,
+	NodeDocumentation,
+	NodeEndMember,
+	NodeEnumerationBody,
+	NodeEnumerationDefinition,
+	NodeEnumerationLiteral,
+	NodeExhibitModifier,
+	NodeExposeMember,
+	NodeFeatureChainExpression,
+	NodeFeatureValue,
+	NodeFlowConnectionUsage,
+	NodeIdentifier,
+	NodeImportFilter,
+	NodeImportRecursive,
+	NodeImportStatement,
+	NodeImportWildcard,
+	NodeIncludeUseCase,
+	NodeIndexExpression,
+	NodeInitialValue,
+	NodeIntegerLiteral,
+	NodeInterfaceDefinition,
+	NodeInterfaceUsage,
+	NodeInvocationExpression,
+	NodeItemDefinition,
+	NodeItemUsage,
+	NodeLibraryModifier,
+	NodeLineComment,
+	NodeMetadataAssignment,
+	NodeMetadataBody,
+	NodeMetadataDefinition,
+	NodeMetadataUsage,
+	NodeMultiplicityModifier,
+	NodeMultiplicityRange,
+	NodeNull,
+	NodeObjectiveMember,
+	NodeOccurrenceDefinition,
+	NodeOccurrenceUsage,
+	NodePackageDecl,
+	NodeParameterMember,
+	NodeParenthesizedExpression,
+	NodePartDef,
+	NodePartUsage,
+	NodePerformModifier,
+	NodePortBody,
+	NodePortDefinition,
+	NodePortUsage,
+	NodePortionUsage,
+	NodeQualifiedName,
+	NodeRealLiteral,
+	NodeRedefinition,
+	NodeReferenceSubsetting,
+	NodeRenderMember,
+	NodeRenderingDefinition,
+	NodeRequireConstraintMember,
+	NodeRequirementBody,
+	NodeRequirementDefinition,
+	NodeRequirementUsage,
+	NodeReturnMember,
+	NodeSatisfyRequirementUsage,
+	NodeSelf,
+	NodeSourceFile,
+	NodeSpecialization,
+	NodeStandardModifier,
+	NodeStateActionMember,
+	NodeStateBody,
+	NodeStateDefinition,
+	NodeStateUsage,
+	NodeStringLiteral,
+	NodeSubjectMember,
+	NodeSubsetting,
+	NodeSuccession,
+	NodeTransitionUsage,
+	NodeTyping,
+	NodeUnaryExpression,
+	NodeUnbounded,
+	NodeUsage,
+	NodeUseCaseDefinition,
+	NodeUseCaseUsage,
+	NodeVerificationDefinition,
+	NodeVerificationUsage,
+	NodeVerifyMember,
+	NodeViewBody,
+	NodeViewDefinition,
+	NodeViewpointDefinition,
+	NodeVisibility,
+}
+
+// Kinds of the named node types, in the order of NamedNodeTypes.
+const (
+	KindActionBody NodeKind = iota + 1
+	KindActionDefinition
+	KindActionUsage
+	KindActorMember
+	KindAliasMember
+	KindAllocationUsage
+	KindAnalysisCaseDefinition
+	KindAnnotation
+	KindArgumentList
+	KindArrowExpression
+	KindAssertConstraintUsage
+	KindAttributeDef
+	KindAttributeUsage
+	KindBinaryExpression
+	KindBindingConnector
+	KindBlock
+	KindBlockComment
+	KindBodyExpression
+	KindBooleanLiteral
+	KindCalcBody
+	KindCalcDefinition
+	KindCalcUsage
+	KindCaseBody
+	KindConditionalExpression
+	KindConjugation
+	KindConnectionBody
+	KindConnectionDefinition
+	KindConnectionUsage
+	KindConstraintBody
+	KindConstraintDefinition
+	KindConstraintUsage
+	KindControlNode
+	KindDefaultValue
+	KindDefinition
+	KindDirectedFeature
+	KindDirection
+	KindDocText
+	KindDocumentation
+	KindEndMember
+	KindEnumerationBody
+	KindEnumerationDefinition
+	KindEnumerationLiteral
+	KindExhibitModifier
+	KindExposeMember
+	KindFeatureChainExpression
+	KindFeatureValue
+	KindFlowConnectionUsage
+	KindIdentifier
+	KindImportFilter
+	KindImportRecursive
+	KindImportStatement
+	KindImportWildcard
+	KindIncludeUseCase
+	KindIndexExpression
+	KindInitialValue
+	KindIntegerLiteral
+	KindInterfaceDefinition
+	KindInterfaceUsage
+	KindInvocationExpression
+	KindItemDefinition
+	KindItemUsage
+	KindLibraryModifier
+	KindLineComment
+	KindMetadataAssignment
+	KindMetadataBody
+	KindMetadataDefinition
+	KindMetadataUsage
+	KindMultiplicityModifier
+	KindMultiplicityRange
+	KindNull
+	KindObjectiveMember
+	KindOccurrenceDefinition
+	KindOccurrenceUsage
+	KindPackageDecl
+	KindParameterMember
+	KindParenthesizedExpression
+	KindPartDef
+	KindPartUsage
+	KindPerformModifier
+	KindPortBody
+	KindPortDefinition
+	KindPortUsage
+	KindPortionUsage
+	KindQualifiedName
+	KindRealLiteral
+	KindRedefinition
+	KindReferenceSubsetting
+	KindRenderMember
+	KindRenderingDefinition
+	KindRequireConstraintMember
+	KindRequirementBody
+	KindRequirementDefinition
+	KindRequirementUsage
+	KindReturnMember
+	KindSatisfyRequirementUsage
+	KindSelf
+	KindSourceFile
+	KindSpecialization
+	KindStandardModifier
+	KindStateActionMember
+	KindStateBody
+	KindStateDefinition
+	KindStateUsage
+	KindStringLiteral
+	KindSubjectMember
+	KindSubsetting
+	KindSuccession
+	KindTransitionUsage
+	KindTyping
+	KindUnaryExpression
+	KindUnbounded
+	KindUsage
+	KindUseCaseDefinition
+	KindUseCaseUsage
+	KindVerificationDefinition
+	KindVerificationUsage
+	KindVerifyMember
+	KindViewBody
+	KindViewDefinition
+	KindViewpointDefinition
+	KindVisibility
+)
+
+var nodeKinds = map[string]NodeKind{
+	NodeActionBody:              KindActionBody,
+	NodeActionDefinition:        KindActionDefinition,
+	NodeActionUsage:             KindActionUsage,
+	NodeActorMember:             KindActorMember,
+	NodeAliasMember:             KindAliasMember,
+	NodeAllocationUsage:         KindAllocationUsage,
+	NodeAnalysisCaseDefinition:  KindAnalysisCaseDefinition,
+	NodeAnnotation:              KindAnnotation,
+	NodeArgumentList:            KindArgumentList,
+	NodeArrowExpression:         KindArrowExpression,
+	NodeAssertConstraintUsage:   KindAssertConstraintUsage,
+	NodeAttributeDef:            KindAttributeDef,
+	NodeAttributeUsage:          KindAttributeUsage,
+	NodeBinaryExpression:        KindBinaryExpression,
+	NodeBindingConnector:        KindBindingConnector,
+	NodeBlock:                   KindBlock,
+	NodeBlockComment:            KindBlockComment,
+	NodeBodyExpression:          KindBodyExpression,
+	NodeBooleanLiteral:          KindBooleanLiteral,
+	NodeCalcBody:                KindCalcBody,
+	NodeCalcDefinition:          KindCalcDefinition,
+	NodeCalcUsage:               KindCalcUsage,
+	NodeCaseBody:                KindCaseBody,
+	NodeConditionalExpression:   KindConditionalExpression,
+	NodeConjugation:             KindConjugation,
+	NodeConnectionBody:          KindConnectionBody,
+	NodeConnectionDefinition:    KindConnectionDefinition,
+	NodeConnectionUsage:         KindConnectionUsage,
+	NodeConstraintBody:          KindConstraintBody,
+	NodeConstraintDefinition:    KindConstraintDefinition,
+	NodeConstraintUsage:         KindConstraintUsage,
+	NodeControlNode:             KindControlNode,
+	NodeDefaultValue:            KindDefaultValue,
+	NodeDefinition:              KindDefinition,
+	NodeDirectedFeature:         KindDirectedFeature,
+	NodeDirection:               KindDirection,
+	NodeDocText:                 KindDocText,
+	NodeDocumentation:           KindDocumentation,
+	NodeEndMember:               KindEndMember,
+	NodeEnumerationBody:         KindEnumerationBody,
+	NodeEnumerationDefinition:   KindEnumerationDefinition,
+	NodeEnumerationLiteral:      KindEnumerationLiteral,
+	NodeExhibitModifier:         KindExhibitModifier,
+	NodeExposeMember:            KindExposeMember,
+	NodeFeatureChainExpression:  KindFeatureChainExpression,
+	NodeFeatureValue:            KindFeatureValue,
+	NodeFlowConnectionUsage:     KindFlowConnectionUsage,
+	NodeIdentifier:              KindIdentifier,
+	NodeImportFilter:            KindImportFilter,
+	NodeImportRecursive:         KindImportRecursive,
+	NodeImportStatement:         KindImportStatement,
+	NodeImportWildcard:          KindImportWildcard,
+	NodeIncludeUseCase:          KindIncludeUseCase,
+	NodeIndexExpression:         KindIndexExpression,
+	NodeInitialValue:            KindInitialValue,
+	NodeIntegerLiteral:          KindIntegerLiteral,
+	NodeInterfaceDefinition:     KindInterfaceDefinition,
+	NodeInterfaceUsage:          KindInterfaceUsage,
+	NodeInvocationExpression:    KindInvocationExpression,
+	NodeItemDefinition:          KindItemDefinition,
+	NodeItemUsage:               KindItemUsage,
+	NodeLibraryModifier:         KindLibraryModifier,
+	NodeLineComment:             KindLineComment,
+	NodeMetadataAssignment:      KindMetadataAssignment,
+	NodeMetadataBody:            KindMetadataBody,
+	NodeMetadataDefinition:      KindMetadataDefinition,
+	NodeMetadataUsage:           KindMetadataUsage,
+	NodeMultiplicityModifier:    KindMultiplicityModifier,
+	NodeMultiplicityRange:       KindMultiplicityRange,
+	NodeNull:                    KindNull,
+	NodeObjectiveMember:         KindObjectiveMember,
+	NodeOccurrenceDefinition:    KindOccurrenceDefinition,
+	NodeOccurrenceUsage:         KindOccurrenceUsage,
+	NodePackageDecl:             KindPackageDecl,
+	NodeParameterMember:         KindParameterMember,
+	NodeParenthesizedExpression: KindParenthesizedExpression,
+	NodePartDef:                 KindPartDef,
+	NodePartUsage:               KindPartUsage,
+	NodePerformModifier:         KindPerformModifier,
+	NodePortBody:                KindPortBody,
+	NodePortDefinition:          KindPortDefinition,
+	NodePortUsage:               KindPortUsage,
+	NodePortionUsage:            KindPortionUsage,
+	NodeQualifiedName:           KindQualifiedName,
+	NodeRealLiteral:             KindRealLiteral,
+	NodeRedefinition:            KindRedefinition,
+	NodeReferenceSubsetting:     KindReferenceSubsetting,
+	NodeRenderMember:            KindRenderMember,
+	NodeRenderingDefinition:     KindRenderingDefinition,
+	NodeRequireConstraintMember: KindRequireConstraintMember,
+	NodeRequirementBody:         KindRequirementBody,
+	NodeRequirementDefinition:   KindRequirementDefinition,
+	NodeRequirementUsage:        KindRequirementUsage,
+	NodeReturnMember:            KindReturnMember,
+	NodeSatisfyRequirementUsage: KindSatisfyRequirementUsage,
+	NodeSelf:                    KindSelf,
+	NodeSourceFile:              KindSourceFile,
+	NodeSpecialization:          KindSpecialization,
+	NodeStandardModifier:        KindStandardModifier,
+	NodeStateActionMember:       KindStateActionMember,
+	NodeStateBody:               KindStateBody,
+	NodeStateDefinition:         KindStateDefinition,
+	NodeStateUsage:              KindStateUsage,
+	NodeStringLiteral:           KindStringLiteral,
+	NodeSubjectMember:           KindSubjectMember,
+	NodeSubsetting:              KindSubsetting,
+	NodeSuccession:              KindSuccession,
+	NodeTransitionUsage:         KindTransitionUsage,
+	NodeTyping:                  KindTyping,
+	NodeUnaryExpression:         KindUnaryExpression,
+	NodeUnbounded:               KindUnbounded,
+	NodeUsage:                   KindUsage,
+	NodeUseCaseDefinition:       KindUseCaseDefinition,
+	NodeUseCaseUsage:            KindUseCaseUsage,
+	NodeVerificationDefinition:  KindVerificationDefinition,
+	NodeVerificationUsage:       KindVerificationUsage,
+	NodeVerifyMember:            KindVerifyMember,
+	NodeViewBody:                KindViewBody,
+	NodeViewDefinition:          KindViewDefinition,
+	NodeViewpointDefinition:     KindViewpointDefinition,
+	NodeVisibility:              KindVisibility,
+}
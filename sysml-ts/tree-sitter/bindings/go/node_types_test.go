@@ -0,0 +1,45 @@
+package tree_sitter_sysml_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+func TestNamedNodeTypesMatchNodeTypesJSON(t *testing.T) {
+	data, err := os.ReadFile("../../src/node-types.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []struct {
+		Type  string `json:"type"`
+		Named bool   `json:"named"`
+	}
+	if err := json.Unmarshal(data, &types); err != nil {
+		t.Fatal(err)
+	}
+	named := map[string]bool{}
+	for _, nt := range types {
+		if nt.Named {
+			named[nt.Type] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, nt := range tree_sitter_sysml.NamedNodeTypes {
+		if !named[nt] {
+			t.Errorf("constant %q is not a named node type in node-types.json", nt)
+		}
+		seen[nt] = true
+	}
+	for nt := range named {
+		if !seen[nt] {
+			t.Errorf("node type %q has no constant; run go generate", nt)
+		}
+	}
+	if tree_sitter_sysml.NodePartDef != "part_def" {
+		t.Errorf("NodePartDef = %q, want %q", tree_sitter_sysml.NodePartDef, "part_def")
+	}
+}
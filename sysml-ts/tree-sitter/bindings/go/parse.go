@@ -0,0 +1,25 @@
+package tree_sitter_sysml
+
+import (
+	"context"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+var language = sitter.NewLanguage(Language())
+
+// Parse parses src as SysML and returns the resulting syntax tree.
+//
+// CRLF line breaks and a leading UTF-8 byte order mark are skipped like any
+// other whitespace, so such a file gives the same tree as its LF equivalent;
+// node offsets still index src, mark included.
+//
+// A fresh parser is allocated for every call, so Parse is safe for
+// concurrent use. An error is returned if ctx is cancelled before parsing
+// completes.
+func Parse(ctx context.Context, src []byte) (*sitter.Tree, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(language)
+	return parser.ParseCtx(ctx, nil, src)
+}
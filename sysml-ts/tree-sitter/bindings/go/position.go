@@ -0,0 +1,71 @@
+package tree_sitter_sysml
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// ByteOffsetToPosition converts the byte offset into src that tree-sitter
+// reports to a zero-based line and a column counted in UTF-16 code units, as
+// used by the Language Server Protocol. A character outside the Basic
+// Multilingual Plane takes two units. An offset inside a multi-byte
+// character is taken as the start of that character, and an offset past the
+// end of src as the end. The carriage return of a CRLF line break belongs to
+// the break, not the line, so it adds no column.
+func ByteOffsetToPosition(src []byte, offset uint32) (line, utf16col uint32) {
+	if int(offset) > len(src) {
+		offset = uint32(len(src))
+	}
+	before := src[:offset]
+	start := bytes.LastIndexByte(before, '\n') + 1
+	line = uint32(bytes.Count(before, []byte("\n")))
+	for i, rest := start, before[start:]; len(rest) > 0; {
+		r, size := utf8.DecodeRune(rest)
+		if r == utf8.RuneError && !utf8.FullRune(rest) || isCRLF(src, i) {
+			break
+		}
+		i += size
+		rest = rest[size:]
+		utf16col += utf16Len(r)
+	}
+	return line, utf16col
+}
+
+// PositionToByteOffset is the inverse of ByteOffsetToPosition: it converts a
+// zero-based line and UTF-16 column to a byte offset into src. A column past
+// the end of its line gives the offset of the line break, the carriage
+// return for CRLF; a column in the middle of a surrogate pair gives the start
+// of that character, and a line past the last gives the end of src.
+func PositionToByteOffset(src []byte, line, utf16col uint32) uint32 {
+	start := 0
+	for ; line > 0; line-- {
+		i := bytes.IndexByte(src[start:], '\n')
+		if i < 0 {
+			return uint32(len(src))
+		}
+		start += i + 1
+	}
+	offset := start
+	for col := uint32(0); offset < len(src) && src[offset] != '\n' && !isCRLF(src, offset); {
+		r, size := utf8.DecodeRune(src[offset:])
+		if col += utf16Len(r); col > utf16col {
+			break
+		}
+		offset += size
+	}
+	return uint32(offset)
+}
+
+// isCRLF reports whether src[i] is the carriage return of a CRLF line break.
+func isCRLF(src []byte, i int) bool {
+	return src[i] == '\r' && i+1 < len(src) && src[i+1] == '\n'
+}
+
+// utf16Len returns the number of UTF-16 code units that encode r. Invalid
+// UTF-8 decodes to U+FFFD, which takes one unit.
+func utf16Len(r rune) uint32 {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
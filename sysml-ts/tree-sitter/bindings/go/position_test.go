@@ -0,0 +1,107 @@
+package tree_sitter_sysml_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+const positionSource = "part def Größe;\n" +
+	"attribute face : String = \"😀 ok\";\n" +
+	"part p;"
+
+// crlfSource is positionSource with CRLF line breaks.
+var crlfSource = strings.ReplaceAll(positionSource, "\n", "\r\n")
+
+func TestByteOffsetToPosition(t *testing.T) {
+	emoji := uint32(strings.Index(positionSource, "😀"))
+	tests := []struct {
+		name      string
+		src       string
+		offset    uint32
+		line, col uint32
+	}{
+		{"start", positionSource, 0, 0, 0},
+		{"ascii", positionSource, 5, 0, 5},
+		{"after two-byte rune", positionSource, uint32(strings.Index(positionSource, "ße")), 0, 12},
+		{"after two-byte runes", positionSource, uint32(strings.Index(positionSource, ";")), 0, 14},
+		{"end of line", positionSource, uint32(strings.Index(positionSource, "\n")), 0, 15},
+		{"start of next line", positionSource, uint32(strings.Index(positionSource, "\n")) + 1, 1, 0},
+		{"on emoji", positionSource, emoji, 1, 27},
+		{"inside emoji", positionSource, emoji + 2, 1, 27},
+		{"after emoji", positionSource, emoji + 4, 1, 29},
+		{"end of emoji line", positionSource, uint32(strings.LastIndex(positionSource, "\n")), 1, 34},
+		{"end of source", positionSource, uint32(len(positionSource)), 2, 7},
+		{"past end of source", positionSource, uint32(len(positionSource)) + 10, 2, 7},
+		{"crlf carriage return", crlfSource, uint32(strings.Index(crlfSource, "\r")), 0, 15},
+		{"crlf line feed", crlfSource, uint32(strings.Index(crlfSource, "\n")), 0, 15},
+		{"crlf start of next line", crlfSource, uint32(strings.Index(crlfSource, "\n")) + 1, 1, 0},
+		{"crlf end of emoji line", crlfSource, uint32(strings.LastIndex(crlfSource, "\r")), 1, 34},
+		{"crlf end of source", crlfSource, uint32(len(crlfSource)), 2, 7},
+		{"lone carriage return", "part\rp;", 5, 0, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, col := tree_sitter_sysml.ByteOffsetToPosition([]byte(tt.src), tt.offset)
+			if line != tt.line || col != tt.col {
+				t.Errorf("ByteOffsetToPosition(%d) = %d:%d, want %d:%d", tt.offset, line, col, tt.line, tt.col)
+			}
+		})
+	}
+}
+
+func TestPositionToByteOffset(t *testing.T) {
+	emoji := uint32(strings.Index(positionSource, "😀"))
+	tests := []struct {
+		name      string
+		src       string
+		line, col uint32
+		offset    uint32
+	}{
+		{"ascii", positionSource, 0, 5, 5},
+		{"after two-byte rune", positionSource, 0, 12, uint32(strings.Index(positionSource, "ße"))},
+		{"end of line", positionSource, 0, 15, uint32(strings.Index(positionSource, "\n"))},
+		{"past end of line", positionSource, 0, 40, uint32(strings.Index(positionSource, "\n"))},
+		{"on emoji", positionSource, 1, 27, emoji},
+		{"inside surrogate pair", positionSource, 1, 28, emoji},
+		{"after emoji", positionSource, 1, 29, emoji + 4},
+		{"last line", positionSource, 2, 5, uint32(len(positionSource)) - 2},
+		{"past last line", positionSource, 5, 0, uint32(len(positionSource))},
+		{"crlf end of line", crlfSource, 0, 15, uint32(strings.Index(crlfSource, "\r"))},
+		{"crlf past end of line", crlfSource, 0, 40, uint32(strings.Index(crlfSource, "\r"))},
+		{"crlf past end of emoji line", crlfSource, 1, 40, uint32(strings.LastIndex(crlfSource, "\r"))},
+		{"crlf last line", crlfSource, 2, 5, uint32(len(crlfSource)) - 2},
+		{"lone carriage return", "part\rp;", 0, 40, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tree_sitter_sysml.PositionToByteOffset([]byte(tt.src), tt.line, tt.col); got != tt.offset {
+				t.Errorf("PositionToByteOffset(%d:%d) = %d, want %d", tt.line, tt.col, got, tt.offset)
+			}
+		})
+	}
+}
+
+func TestPositionRoundTrip(t *testing.T) {
+	for _, source := range []string{positionSource, crlfSource} {
+		src := []byte(source)
+		for offset := 0; offset <= len(src); {
+			// The line feed of a CRLF has the position of its carriage return.
+			if offset > 0 && src[offset-1] == '\r' && offset < len(src) && src[offset] == '\n' {
+				offset++
+				continue
+			}
+			line, col := tree_sitter_sysml.ByteOffsetToPosition(src, uint32(offset))
+			if got := tree_sitter_sysml.PositionToByteOffset(src, line, col); got != uint32(offset) {
+				t.Errorf("%q: offset %d maps to %d:%d, which maps back to %d", source, offset, line, col, got)
+			}
+			if offset == len(src) {
+				break
+			}
+			_, size := utf8.DecodeRune(src[offset:])
+			offset += size
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package tree_sitter_sysml_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+// loadQuery compiles the shipped query file queries/name.
+func loadQuery(t *testing.T, name string) *tree_sitter.Query {
+	t.Helper()
+	source, err := os.ReadFile("../../queries/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := tree_sitter.NewQuery(source, tree_sitter.NewLanguage(tree_sitter_sysml.Language()))
+	if err != nil {
+		t.Fatalf("%s does not compile: %v", name, err)
+	}
+	return q
+}
+
+// queryCapture is one capture of a query match. match numbers the matches
+// in the order the cursor returns them, and pattern is the index of the
+// pattern that matched, for looking up its predicates.
+type queryCapture struct {
+	name    string
+	node    *tree_sitter.Node
+	match   int
+	pattern uint32
+}
+
+// captures runs q over the tree rooted at root and returns the captures of
+// every match in order.
+func captures(t *testing.T, q *tree_sitter.Query, root *tree_sitter.Node) []queryCapture {
+	t.Helper()
+	var caps []queryCapture
+	qc := tree_sitter.NewQueryCursor()
+	defer qc.Close()
+	qc.Exec(q, root)
+	for match := 0; ; match++ {
+		m, ok := qc.NextMatch()
+		if !ok {
+			return caps
+		}
+		for _, c := range m.Captures {
+			caps = append(caps, queryCapture{
+				name:    q.CaptureNameForId(c.Index),
+				node:    c.Node,
+				match:   match,
+				pattern: uint32(m.PatternIndex),
+			})
+		}
+	}
+}
+
+// TestQueriesMatchFixture compiles every shipped query and runs it over a
+// fixture that is meant to exercise all of their captures. A capture that
+// never matches usually means a grammar change renamed or restructured the
+// node it targets.
+func TestQueriesMatchFixture(t *testing.T) {
+	files, err := filepath.Glob("../../queries/*.scm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no query files found")
+	}
+	tree, _ := parseFixture(t, "queries.sysml")
+	if diags := tree_sitter_sysml.Diagnostics(tree); len(diags) != 0 {
+		t.Fatalf("queries.sysml does not parse cleanly: %+v", diags)
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			q := loadQuery(t, filepath.Base(file))
+			matched := map[string]bool{}
+			for _, c := range captures(t, q, tree.RootNode()) {
+				matched[c.name] = true
+			}
+			for id := uint32(0); id < q.CaptureCount(); id++ {
+				if name := q.CaptureNameForId(id); !matched[name] {
+					t.Errorf("@%s never matches queries.sysml", name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package tree_sitter_sysml
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+const (
+	// readerChunk is the number of bytes handed to the parser per read.
+	readerChunk = 64 << 10
+	// readerHistory is how far behind the latest offset it has asked for the
+	// parser may still rewind. It only backs up to re-scan the last few
+	// tokens, so this is generous.
+	readerHistory = 256 << 10
+	// readerEmptyReads is how many reads in a row may return no data before
+	// the reader is considered stuck, as in bufio.
+	readerEmptyReads = 100
+)
+
+// ParseReader parses the SysML source read from r and returns the resulting
+// syntax tree. Unlike Parse it never holds the whole source in memory: the
+// parser pulls the text in chunks, and only a window of recently read bytes
+// is kept for the lexer to back up into.
+//
+// The tree does not retain the source, so Node.Content cannot be used on it;
+// node positions are byte offsets into the stream. An error is returned if r
+// fails or if ctx is cancelled before parsing completes. Cancellation is
+// noticed whenever the parser asks for more input.
+func ParseReader(ctx context.Context, r io.Reader) (*sitter.Tree, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(language)
+
+	in := &readerInput{ctx: ctx, r: r}
+	tree, err := parser.ParseInputCtx(ctx, nil, sitter.Input{
+		Read:     in.read,
+		Encoding: sitter.InputEncodingUTF8,
+	})
+	if err == nil {
+		err = in.err
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		if tree != nil {
+			tree.Close()
+		}
+		return nil, err
+	}
+	return tree, nil
+}
+
+// readerInput feeds the parser from an io.Reader. buf holds the bytes of the
+// source starting at offset base; bytes further back than readerHistory are
+// discarded as the parser moves on.
+type readerInput struct {
+	ctx  context.Context
+	r    io.Reader
+	buf  []byte
+	base uint32
+	eof  bool
+	// err records why reading stopped early. The parser cannot be told about
+	// it, so read reports the end of input instead and ParseReader returns
+	// err once the parser finishes.
+	err error
+}
+
+// read returns the source from offset on. The chunk never ends inside a
+// UTF-8 sequence unless the source itself does, so the lexer always sees
+// whole characters.
+func (in *readerInput) read(offset uint32, _ sitter.Point) []byte {
+	if in.err != nil {
+		return nil
+	}
+	if err := in.ctx.Err(); err != nil {
+		in.err = err
+		return nil
+	}
+	if offset < in.base {
+		in.err = fmt.Errorf("parser rewound to byte %d, before the %d bytes kept from the reader",
+			offset, readerHistory)
+		return nil
+	}
+	in.discard(offset)
+	for !in.eof && len(in.buf)-int(offset-in.base) < readerChunk {
+		in.fill()
+	}
+	if in.err != nil || int(offset-in.base) >= len(in.buf) {
+		return nil
+	}
+	chunk := in.buf[offset-in.base:]
+	if len(chunk) > readerChunk {
+		chunk = chunk[:readerChunk]
+	}
+	if !in.eof || len(chunk) < len(in.buf)-int(offset-in.base) {
+		chunk = trimPartialRune(chunk)
+	}
+	return chunk
+}
+
+// fill appends the next bytes of the reader to buf.
+func (in *readerInput) fill() {
+	if len(in.buf) == cap(in.buf) {
+		buf := make([]byte, len(in.buf), len(in.buf)+readerChunk)
+		copy(buf, in.buf)
+		in.buf = buf
+	}
+	for i := 0; i < readerEmptyReads; i++ {
+		n, err := in.r.Read(in.buf[len(in.buf):cap(in.buf)])
+		in.buf = in.buf[:len(in.buf)+n]
+		switch {
+		case err == io.EOF:
+			in.eof = true
+			return
+		case err != nil:
+			in.eof = true
+			in.err = err
+			return
+		case n > 0:
+			return
+		}
+	}
+	in.eof = true
+	in.err = io.ErrNoProgress
+}
+
+// discard drops the bytes more than readerHistory behind offset, shifting
+// the rest to the front of buf.
+func (in *readerInput) discard(offset uint32) {
+	if offset-in.base <= readerHistory {
+		return
+	}
+	drop := offset - in.base - readerHistory
+	if int(drop) > len(in.buf) {
+		drop = uint32(len(in.buf))
+	}
+	in.buf = in.buf[:copy(in.buf, in.buf[drop:])]
+	in.base += drop
+}
+
+// trimPartialRune cuts an incomplete UTF-8 sequence off the end of b. Bytes
+// that can never start a valid sequence are left in place for the lexer to
+// reject.
+func trimPartialRune(b []byte) []byte {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(b[i]) {
+			continue
+		}
+		if !utf8.FullRune(b[i:]) && i > 0 {
+			return b[:i]
+		}
+		break
+	}
+	return b
+}
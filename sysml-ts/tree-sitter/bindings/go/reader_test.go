@@ -0,0 +1,105 @@
+package tree_sitter_sysml_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+// largeSource returns a SysML document of over a megabyte whose doc comments
+// and strings are dense with multi-byte characters, so that chunk edges fall
+// inside UTF-8 sequences.
+func largeSource() []byte {
+	var b bytes.Buffer
+	for i := 0; b.Len() < 1<<20; i++ {
+		fmt.Fprintf(&b, "package P%d {\n", i)
+		fmt.Fprintf(&b, "  doc /* Größe ✓ %s */\n", strings.Repeat("ü€", i%7+1))
+		fmt.Fprintf(&b, "  part def Vehicle%d :> Base {\n", i)
+		fmt.Fprintf(&b, "    attribute label : String = \"Fahrzeug №%d ✓\";\n", i)
+		fmt.Fprintf(&b, "    attribute mass : Real = %d.5e-3;\n", i)
+		b.WriteString("    part wheels : Wheel [4];\n  }\n}\n")
+	}
+	return b.Bytes()
+}
+
+// treeLayout lists every named node of tree with its byte range.
+func treeLayout(tree *tree_sitter.Tree) string {
+	var b strings.Builder
+	tree_sitter_sysml.Walk(tree.RootNode(), func(n *tree_sitter.Node) bool {
+		fmt.Fprintf(&b, "%s %d %d\n", n.Type(), n.StartByte(), n.EndByte())
+		return true
+	})
+	return b.String()
+}
+
+func TestParseReaderMatchesParse(t *testing.T) {
+	src := largeSource()
+	want, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want.RootNode().HasError() {
+		t.Fatal("the synthetic source does not parse cleanly")
+	}
+
+	readers := map[string]io.Reader{
+		"whole":    bytes.NewReader(src),
+		"one byte": iotest.OneByteReader(bytes.NewReader(src)),
+		"half":     iotest.HalfReader(bytes.NewReader(src)),
+	}
+	for name, r := range readers {
+		t.Run(name, func(t *testing.T) {
+			got, err := tree_sitter_sysml.ParseReader(context.Background(), r)
+			if err != nil {
+				t.Fatalf("ParseReader returned an error: %v", err)
+			}
+			if got.RootNode().EndByte() != uint32(len(src)) {
+				t.Errorf("tree ends at byte %d, want %d", got.RootNode().EndByte(), len(src))
+			}
+			if treeLayout(got) != treeLayout(want) {
+				t.Error("ParseReader tree differs from the Parse tree")
+			}
+		})
+	}
+}
+
+func TestParseReaderError(t *testing.T) {
+	boom := errors.New("boom")
+	r := io.MultiReader(strings.NewReader("part def Engine;\n"), iotest.ErrReader(boom))
+	if _, err := tree_sitter_sysml.ParseReader(context.Background(), r); !errors.Is(err, boom) {
+		t.Errorf("ParseReader error = %v, want %v", err, boom)
+	}
+}
+
+// cancellingReader cancels its context once after bytes have been read.
+type cancellingReader struct {
+	r      io.Reader
+	after  int
+	read   int
+	cancel context.CancelFunc
+}
+
+func (c *cancellingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if c.read += n; c.read >= c.after {
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestParseReaderCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := &cancellingReader{r: bytes.NewReader(largeSource()), after: 256 << 10, cancel: cancel}
+	if _, err := tree_sitter_sysml.ParseReader(ctx, r); !errors.Is(err, context.Canceled) {
+		t.Errorf("ParseReader error = %v, want %v", err, context.Canceled)
+	}
+}
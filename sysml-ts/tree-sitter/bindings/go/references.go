@@ -0,0 +1,93 @@
+package tree_sitter_sysml
+
+import sitter "github.com/smacker/go-tree-sitter"
+
+// Reference is a qualified name that a SysML document uses to refer to an
+// element declared elsewhere, possibly in another file.
+type Reference struct {
+	// Name is the qualified name as written, such as "Parts::Engine".
+	Name string
+	// Segments holds the identifiers of Name in order.
+	Segments []string
+	Range    Range
+	// Context tells how the name is used: "import", "expose", "alias",
+	// "typing", "specialization", "subsetting", "redefinition", "reference",
+	// "metadata", "verify", "satisfy", "assert", "perform", "exhibit",
+	// "include" or "render".
+	Context string
+}
+
+// referenceSite is the field of a node that holds a referenced name, and the
+// Context reported for it.
+type referenceSite struct {
+	field   string
+	context string
+}
+
+var referenceSites = map[string]referenceSite{
+	NodeImportStatement:         {"name", "import"},
+	NodeAliasMember:             {"target", "alias"},
+	NodeTyping:                  {"type", "typing"},
+	NodeSpecialization:          {"target", "specialization"},
+	NodeSubsetting:              {"target", "subsetting"},
+	NodeRedefinition:            {"target", "redefinition"},
+	NodeReferenceSubsetting:     {"target", "reference"},
+	NodeAnnotation:              {"type", "metadata"},
+	NodeMetadataUsage:           {"type", "metadata"},
+	NodeImportFilter:            {"metadata", "metadata"},
+	NodeVerifyMember:            {"requirement", "verify"},
+	NodeActionUsage:             {"target", "perform"},
+	NodeStateUsage:              {"target", "exhibit"},
+	NodeIncludeUseCase:          {"target", "include"},
+	NodeSatisfyRequirementUsage: {"satisfied", "satisfy"},
+	NodeAssertConstraintUsage:   {"constraint", "assert"},
+	NodeExposeMember:            {"name", "expose"},
+	NodeRenderMember:            {"rendering", "render"},
+}
+
+// References returns the qualified names that tree, whose source text is
+// src, refers to by import or expose, alias, typing, a relationship, a
+// metadata annotation or import filter, a verify or satisfy relationship, an
+// asserted constraint, a perform, exhibit or include usage, or a rendering,
+// in document order. The names of declarations and the names used inside
+// expressions are not included.
+func References(tree *sitter.Tree, src []byte) []Reference {
+	var refs []Reference
+	Walk(tree.RootNode(), func(n *sitter.Node) bool {
+		site, ok := referenceSites[n.Type()]
+		if !ok {
+			return true
+		}
+		refs = appendReferences(refs, n, src, site)
+		return true
+	})
+	return refs
+}
+
+// appendReferences appends the qualified names in the site field of n. A
+// cursor is used because it reports the field of every child, including the
+// repeated target fields of a relationship.
+func appendReferences(refs []Reference, n *sitter.Node, src []byte, site referenceSite) []Reference {
+	cursor := sitter.NewTreeCursor(n)
+	defer cursor.Close()
+	for ok := cursor.GoToFirstChild(); ok; ok = cursor.GoToNextSibling() {
+		child := cursor.CurrentNode()
+		if child.Type() == NodeQualifiedName && cursor.CurrentFieldName() == site.field {
+			refs = append(refs, newReference(child, src, site.context))
+		}
+	}
+	return refs
+}
+
+func newReference(n *sitter.Node, src []byte, context string) Reference {
+	segments := make([]string, 0, n.NamedChildCount())
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		segments = append(segments, n.NamedChild(i).Content(src))
+	}
+	return Reference{
+		Name:     n.Content(src),
+		Segments: segments,
+		Range:    Range{Start: n.StartByte(), End: n.EndByte()},
+		Context:  context,
+	}
+}
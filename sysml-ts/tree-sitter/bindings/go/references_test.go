@@ -0,0 +1,53 @@
+package tree_sitter_sysml_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+func TestReferences(t *testing.T) {
+	tree, src := parseFixture(t, "references.sysml")
+	refs := tree_sitter_sysml.References(tree, src)
+
+	var b strings.Builder
+	for _, r := range refs {
+		b.WriteString(r.Context + " " + strings.Join(r.Segments, "/") + "\n")
+	}
+	want := `import Parts
+metadata Safety
+import ISQ/MassValue
+alias Parts/Engine
+specialization Base/Machine
+metadata Safety
+typing Parts/Engine
+typing MassValue
+typing Wheel
+subsetting wheels
+perform Behaviors/drive
+typing Vehicle
+redefinition mass
+redefinition Base/Machine/weight
+satisfy Limits/MassLimit
+assert Limits/Overloaded
+expose Vehicles
+render Views/asTree
+verify Limits/MassLimit
+`
+	if got := b.String(); got != want {
+		t.Errorf("References:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestReferenceRanges(t *testing.T) {
+	tree, src := parseFixture(t, "references.sysml")
+	for _, r := range tree_sitter_sysml.References(tree, src) {
+		if text := string(src[r.Range.Start:r.Range.End]); text != r.Name {
+			t.Errorf("%s reference %q covers %q", r.Context, r.Name, text)
+		}
+		if got := strings.Join(r.Segments, "::"); got != r.Name {
+			t.Errorf("%s reference %q has segments %q", r.Context, r.Name, r.Segments)
+		}
+	}
+}
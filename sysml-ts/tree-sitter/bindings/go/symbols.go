@@ -0,0 +1,127 @@
+package tree_sitter_sysml
+
+import sitter "github.com/smacker/go-tree-sitter"
+
+// Range is a half-open span of byte offsets into the parsed source.
+type Range struct {
+	Start uint32
+	End   uint32
+}
+
+// Symbol is a named declaration in a SysML document, such as a package, a
+// definition or a usage. Children holds the symbols declared in its body.
+type Symbol struct {
+	Name     string
+	Kind     string
+	Range    Range
+	Children []Symbol
+}
+
+// symbolKinds maps the node types that declare a symbol to the keyword
+// reported as its Kind. The generic definition and usage rules are absent
+// because their kind is taken from the keyword in the source, as is the kind
+// of a snapshot or timeslice.
+var symbolKinds = map[string]string{
+	NodePackageDecl:             "package",
+	NodePartDef:                 "part def",
+	NodePartUsage:               "part",
+	NodeAttributeDef:            "attribute def",
+	NodeAttributeUsage:          "attribute",
+	NodeRequirementDefinition:   "requirement def",
+	NodeRequirementUsage:        "requirement",
+	NodeSubjectMember:           "subject",
+	NodeRequireConstraintMember: "constraint",
+	NodeStateDefinition:         "state def",
+	NodeStateUsage:              "state",
+	NodeTransitionUsage:         "transition",
+	NodeConnectionDefinition:    "connection def",
+	NodeConnectionUsage:         "connection",
+	NodeInterfaceDefinition:     "interface def",
+	NodeInterfaceUsage:          "interface",
+	NodeEndMember:               "end",
+	NodeCalcDefinition:          "calc def",
+	NodeCalcUsage:               "calc",
+	NodeParameterMember:         "parameter",
+	NodeActionDefinition:        "action def",
+	NodeActionUsage:             "action",
+	NodeEnumerationDefinition:   "enum def",
+	NodeEnumerationLiteral:      "enum",
+	NodeConstraintDefinition:    "constraint def",
+	NodeConstraintUsage:         "constraint",
+	NodeAssertConstraintUsage:   "constraint",
+	NodePortDefinition:          "port def",
+	NodePortUsage:               "port",
+	NodeDirectedFeature:         "feature",
+	NodeItemDefinition:          "item def",
+	NodeItemUsage:               "item",
+	NodeFlowConnectionUsage:     "flow",
+	NodeMetadataDefinition:      "metadata def",
+	NodeMetadataUsage:           "metadata",
+	NodeVerificationDefinition:  "verification def",
+	NodeVerificationUsage:       "verification",
+	NodeAnalysisCaseDefinition:  "analysis def",
+	NodeObjectiveMember:         "objective",
+	NodeOccurrenceDefinition:    "occurrence def",
+	NodeOccurrenceUsage:         "occurrence",
+	NodeUseCaseDefinition:       "use case def",
+	NodeUseCaseUsage:            "use case",
+	NodeIncludeUseCase:          "include use case",
+	NodeActorMember:             "actor",
+	NodeAllocationUsage:         "allocation",
+	NodeViewDefinition:          "view def",
+	NodeViewpointDefinition:     "viewpoint def",
+	NodeRenderingDefinition:     "rendering def",
+	NodeRenderMember:            "rendering",
+}
+
+// Symbols returns the hierarchy of symbols declared in tree, whose source
+// text is src. Declarations without a name are not reported, but anything
+// declared inside them is attached to the nearest named ancestor instead.
+func Symbols(tree *sitter.Tree, src []byte) []Symbol {
+	return collectSymbols(tree.RootNode(), src)
+}
+
+func collectSymbols(n *sitter.Node, src []byte) []Symbol {
+	var symbols []Symbol
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		kind, ok := symbolKind(child)
+		name := child.ChildByFieldName("name")
+		if !ok || name == nil {
+			symbols = append(symbols, collectSymbols(child, src)...)
+			continue
+		}
+		symbols = append(symbols, Symbol{
+			Name:     name.Content(src),
+			Kind:     kind,
+			Range:    Range{Start: child.StartByte(), End: child.EndByte()},
+			Children: collectSymbols(child, src),
+		})
+	}
+	return symbols
+}
+
+// symbolKind reports the Kind of a declaring node, and false for any other
+// node.
+func symbolKind(n *sitter.Node) (string, bool) {
+	switch n.Type() {
+	case NodeDefinition:
+		return leadingKeyword(n) + " def", true
+	case NodeUsage:
+		return leadingKeyword(n), true
+	case NodePortionUsage:
+		return n.ChildByFieldName("portion").Type(), true
+	}
+	kind, ok := symbolKinds[n.Type()]
+	return kind, ok
+}
+
+// leadingKeyword returns the first keyword of a generic definition or usage.
+func leadingKeyword(n *sitter.Node) string {
+	for i := 0; i < int(n.ChildCount()); i++ {
+		if child := n.Child(i); !child.IsNamed() {
+			return child.Type()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package tree_sitter_sysml_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+// outline renders symbols one per line as "kind name", indented by depth.
+func outline(symbols []tree_sitter_sysml.Symbol, depth int, b *strings.Builder) {
+	for _, s := range symbols {
+		b.WriteString(strings.Repeat("  ", depth) + s.Kind + " " + s.Name + "\n")
+		outline(s.Children, depth+1, b)
+	}
+}
+
+func TestSymbols(t *testing.T) {
+	tree, src := parseFixture(t, "symbols.sysml")
+	symbols := tree_sitter_sysml.Symbols(tree, src)
+
+	var b strings.Builder
+	outline(symbols, 0, &b)
+	want := `package Vehicles
+  part def Vehicle
+    part engine
+    attribute mass
+  requirement def MassLimit
+    subject vehicle
+package Parts
+  part def Engine
+    port fuel
+  enum def Fuel
+    enum petrol
+    enum diesel
+  occurrence def Trip
+    snapshot departure
+    timeslice leg
+`
+	if got := b.String(); got != want {
+		t.Errorf("Symbols outline:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSymbolRanges(t *testing.T) {
+	tree, src := parseFixture(t, "symbols.sysml")
+	symbols := tree_sitter_sysml.Symbols(tree, src)
+
+	var check func(symbols []tree_sitter_sysml.Symbol, parent tree_sitter_sysml.Range)
+	check = func(symbols []tree_sitter_sysml.Symbol, parent tree_sitter_sysml.Range) {
+		for _, s := range symbols {
+			if s.Range.Start < parent.Start || s.Range.End > parent.End {
+				t.Errorf("%s %s range %v lies outside its parent %v", s.Kind, s.Name, s.Range, parent)
+			}
+			if text := string(src[s.Range.Start:s.Range.End]); !strings.Contains(text, s.Name) {
+				t.Errorf("%s %s range covers %q", s.Kind, s.Name, text)
+			}
+			check(s.Children, s.Range)
+		}
+	}
+	check(symbols, tree_sitter_sysml.Range{Start: 0, End: uint32(len(src))})
+
+	got := symbols[1].Range
+	want := tree_sitter_sysml.Range{
+		Start: uint32(strings.Index(string(src), "package Parts")),
+		End:   uint32(len(strings.TrimRight(string(src), "\n"))),
+	}
+	if got != want {
+		t.Errorf("package Parts range = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package tree_sitter_sysml_test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTags(t *testing.T) {
+	q := loadQuery(t, "tags.scm")
+	tree, src := parseFixture(t, "tags.sysml")
+
+	// Each match pairs a tag capture with its @name; render it as
+	// "tag name".
+	var b strings.Builder
+	caps := captures(t, q, tree.RootNode())
+	for i := 0; i < len(caps); {
+		var tag, name string
+		for match := caps[i].match; i < len(caps) && caps[i].match == match; i++ {
+			if caps[i].name == "name" {
+				name = caps[i].node.Content(src)
+			} else {
+				tag = caps[i].name
+			}
+		}
+		b.WriteString(tag + " " + name + "\n")
+	}
+	want := `definition.class Vehicles
+definition.class Vehicle
+reference.class Machine
+reference.class Engine
+reference.class FuelPort
+definition.interface FuelPort
+definition.interface FuelLine
+definition.function Range
+reference.class Real
+definition.function Drive
+`
+	if got := b.String(); got != want {
+		t.Errorf("tags:\n%s\nwant:\n%s", got, want)
+	}
+}
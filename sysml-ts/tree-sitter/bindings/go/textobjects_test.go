@@ -0,0 +1,72 @@
+package tree_sitter_sysml_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// selections returns the text each capture of textobjects.scm selects in the
+// fixture, keyed by capture name, with the #offset! directives applied.
+// Every offset in the query stays on one row, so the column deltas are byte
+// deltas.
+func selections(t *testing.T) map[string][]string {
+	t.Helper()
+	q := loadQuery(t, "textobjects.scm")
+	tree, src := parseFixture(t, "textobjects.sysml")
+
+	got := map[string][]string{}
+	for _, c := range captures(t, q, tree.RootNode()) {
+		var startDelta, endDelta int
+		for _, steps := range q.PredicatesForPattern(c.pattern) {
+			if q.StringValueForId(steps[0].ValueId) == "offset!" {
+				startDelta, _ = strconv.Atoi(q.StringValueForId(steps[3].ValueId))
+				endDelta, _ = strconv.Atoi(q.StringValueForId(steps[5].ValueId))
+			}
+		}
+		start := int(c.node.StartByte()) + startDelta
+		end := int(c.node.EndByte()) + endDelta
+		got[c.name] = append(got[c.name], strings.TrimSpace(string(src[start:end])))
+	}
+	return got
+}
+
+func TestTextObjects(t *testing.T) {
+	got := selections(t)
+	_, src := parseFixture(t, "textobjects.sysml")
+	pkg := strings.TrimSpace(string(src))
+	pkgBody := strings.TrimSpace(pkg[len("package Vehicles {") : len(pkg)-1])
+
+	tests := []struct {
+		capture string
+		want    []string
+	}{
+		{"function.outer", []string{
+			"calc def Torque {\n    in rpm : Real;\n    return : Real;\n  }",
+			"action def Start {\n    first ignite then run;\n  }",
+		}},
+		{"function.inner", []string{
+			"in rpm : Real;\n    return : Real;",
+			"first ignite then run;",
+		}},
+		// The doc prefix belongs to the part definition it documents.
+		{"class.outer", []string{
+			pkg,
+			"doc /* Engines. */\n  part def Engine {\n    attribute mass;\n  }",
+		}},
+		{"class.inner", []string{pkgBody, "attribute mass;"}},
+		{"comment.outer", []string{"doc /* Engines. */"}},
+	}
+	for _, tt := range tests {
+		sel := got[tt.capture]
+		if len(sel) != len(tt.want) {
+			t.Errorf("@%s selected %q, want %q", tt.capture, sel, tt.want)
+			continue
+		}
+		for i := range sel {
+			if sel[i] != tt.want[i] {
+				t.Errorf("@%s[%d] = %q, want %q", tt.capture, i, sel[i], tt.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package tree_sitter_sysml
+
+import sitter "github.com/smacker/go-tree-sitter"
+
+// Walk visits the named nodes of the subtree rooted at root in pre-order,
+// starting with root itself. When fn returns false the children of that node
+// are skipped. A single tree cursor is reused for the whole traversal.
+func Walk(root *sitter.Node, fn func(*sitter.Node) bool) {
+	cursor := sitter.NewTreeCursor(root)
+	defer cursor.Close()
+	for {
+		node := cursor.CurrentNode()
+		descend := true
+		if node.IsNamed() {
+			descend = fn(node)
+		}
+		if descend && cursor.GoToFirstChild() {
+			continue
+		}
+		for !cursor.GoToNextSibling() {
+			if !cursor.GoToParent() {
+				return
+			}
+		}
+	}
+}
+
+// FindAll returns every node of the given type in the subtree rooted at root,
+// in document order.
+func FindAll(root *sitter.Node, nodeType string) []*sitter.Node {
+	var nodes []*sitter.Node
+	Walk(root, func(n *sitter.Node) bool {
+		if n.Type() == nodeType {
+			nodes = append(nodes, n)
+		}
+		return true
+	})
+	return nodes
+}
+
+// NodeAtOffset returns the smallest named node of tree whose byte range
+// contains offset, or nil if offset lies outside the root node. Ranges are
+// half-open, so an offset on the boundary between two nodes belongs to the
+// node that starts there. An offset inside a keyword or punctuation token
+// gives the named node the token belongs to, and one in whitespace the
+// innermost node around it. The descent uses a tree cursor, which seeks to
+// the child holding offset without visiting its siblings one by one.
+func NodeAtOffset(tree *sitter.Tree, offset uint32) *sitter.Node {
+	root := tree.RootNode()
+	if offset < root.StartByte() || offset >= root.EndByte() {
+		return nil
+	}
+	cursor := sitter.NewTreeCursor(root)
+	defer cursor.Close()
+	node := root
+	// The cursor moves to the first child that ends at or after the byte it
+	// is given, so asking for offset+1 skips a child that ends at offset. That
+	// child holds offset unless offset falls in the gap before it.
+	for cursor.GoToFirstChildForByte(offset+1) >= 0 {
+		child := cursor.CurrentNode()
+		if child.StartByte() > offset {
+			break
+		}
+		if child.IsNamed() {
+			node = child
+		}
+	}
+	return node
+}
@@ -0,0 +1,113 @@
+package tree_sitter_sysml_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	tree_sitter "github.com/smacker/go-tree-sitter"
+	"github.com/tree-sitter/tree-sitter-sysml"
+)
+
+func parseFixture(t *testing.T, name string) (*tree_sitter.Tree, []byte) {
+	t.Helper()
+	src, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := tree_sitter_sysml.Parse(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tree, src
+}
+
+func TestFindAll(t *testing.T) {
+	tree, src := parseFixture(t, "vehicle.sysml")
+	root := tree.RootNode()
+
+	tests := []struct {
+		nodeType string
+		want     []string
+	}{
+		{tree_sitter_sysml.NodePackageDecl, []string{"Vehicles", "Parts"}},
+		{tree_sitter_sysml.NodePartDef, []string{"Vehicle", "Engine", "Wheel"}},
+		{tree_sitter_sysml.NodePartUsage, []string{"engine", "wheels", "spare"}},
+	}
+	for _, tt := range tests {
+		nodes := tree_sitter_sysml.FindAll(root, tt.nodeType)
+		if len(nodes) != len(tt.want) {
+			t.Errorf("FindAll(%q) found %d nodes, want %d", tt.nodeType, len(nodes), len(tt.want))
+			continue
+		}
+		for i, n := range nodes {
+			if got := n.ChildByFieldName("name").Content(src); got != tt.want[i] {
+				t.Errorf("FindAll(%q)[%d] is named %q, want %q", tt.nodeType, i, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestWalkSkipsChildren(t *testing.T) {
+	tree, _ := parseFixture(t, "vehicle.sysml")
+
+	var parts int
+	tree_sitter_sysml.Walk(tree.RootNode(), func(n *tree_sitter.Node) bool {
+		if !n.IsNamed() {
+			t.Errorf("Walk visited anonymous node %q", n.Type())
+		}
+		if n.Type() == tree_sitter_sysml.NodePartUsage {
+			parts++
+		}
+		// Do not look inside part definitions.
+		return n.Type() != tree_sitter_sysml.NodePartDef
+	})
+	if parts != 1 {
+		t.Errorf("counted %d part usages outside part definitions, want 1", parts)
+	}
+}
+
+func TestNodeAtOffset(t *testing.T) {
+	tree, src := parseFixture(t, "vehicle.sysml")
+	at := func(s string, delta int) uint32 {
+		i := bytes.Index(src, []byte(s))
+		if i < 0 {
+			t.Fatalf("fixture has no %q", s)
+		}
+		return uint32(i + delta)
+	}
+
+	tests := []struct {
+		name     string
+		offset   uint32
+		nodeType string
+		content  string
+	}{
+		{"identifier", at("engine", 0), tree_sitter_sysml.NodeIdentifier, "engine"},
+		{"inside identifier", at("engine", 3), tree_sitter_sysml.NodeIdentifier, "engine"},
+		{"inside keyword", at("attribute", 4), tree_sitter_sysml.NodeAttributeUsage, "attribute mass : Real;"},
+		{"after identifier", at("engine :", 6), tree_sitter_sysml.NodePartUsage, "part engine : Parts::Engine;"},
+		{"start of segment", at("::Engine", 2), tree_sitter_sysml.NodeIdentifier, "Engine"},
+		{"on separator", at("::Engine", 0), tree_sitter_sysml.NodeQualifiedName, "Parts::Engine"},
+		{"whitespace in body", at("  part engine", 0), tree_sitter_sysml.NodeBlock, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := tree_sitter_sysml.NodeAtOffset(tree, tt.offset)
+			if n == nil {
+				t.Fatalf("NodeAtOffset(%d) = nil", tt.offset)
+			}
+			if n.Type() != tt.nodeType {
+				t.Errorf("NodeAtOffset(%d) is a %s, want %s", tt.offset, n.Type(), tt.nodeType)
+			}
+			if tt.content != "" && n.Content(src) != tt.content {
+				t.Errorf("NodeAtOffset(%d) spans %q, want %q", tt.offset, n.Content(src), tt.content)
+			}
+		})
+	}
+
+	if n := tree_sitter_sysml.NodeAtOffset(tree, uint32(len(src))+1); n != nil {
+		t.Errorf("NodeAtOffset past the end = %s, want nil", n.Type())
+	}
+}
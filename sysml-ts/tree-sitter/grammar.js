@@ -10,10 +10,40 @@ const enumValues = Array.from(
   new Set(Object.values(enums).flat())
 ).sort();
 
+const binaryOperators = operators.filter((op) =>
+  [
+    "null_coalescing",
+    "implies",
+    "or",
+    "conditional_or",
+    "xor",
+    "and",
+    "conditional_and",
+    "equality",
+    "relational",
+    "additive",
+    "multiplicative",
+    "exponentiation",
+  ].includes(op.category)
+);
+
+const operatorPrecedence = (category) =>
+  operators.find((op) => op.category === category).precedence;
+
+const unarySymbols = operators.find((op) => op.category === "unary").symbols;
+
+const PREC = {
+  conditional: operatorPrecedence("conditional"),
+  unary: operatorPrecedence("unary"),
+  call: Math.max(...operators.map((op) => op.precedence)) + 1,
+};
+
+const commaSep1 = (rule) => seq(rule, repeat(seq(",", rule)));
+
 module.exports = grammar({
   name: "sysml",
 
-  extras: ($) => [/\s/, $.comment],
+  extras: ($) => [/\s/, $.line_comment, $.block_comment],
 
   word: ($) => $.identifier,
 
@@ -22,34 +52,139 @@ module.exports = grammar({
 
     _statement: ($) =>
       choice(
+        $.documentation,
         $.package_decl,
-        $.import_decl,
+        $.import_statement,
+        $.alias_member,
         $.part_def,
         $.part_usage,
         $.attribute_def,
         $.attribute_usage,
+        $.requirement_definition,
+        $.requirement_usage,
+        $.state_definition,
+        $.state_usage,
+        $.connection_definition,
+        $.connection_usage,
+        $.interface_definition,
+        $.interface_usage,
+        $.binding_connector,
+        $.calc_definition,
+        $.calc_usage,
+        $.action_definition,
+        $.action_usage,
+        $.enumeration_definition,
+        $.constraint_definition,
+        $.constraint_usage,
+        $.assert_constraint_usage,
+        $.port_definition,
+        $.port_usage,
+        $.item_definition,
+        $.item_usage,
+        $.flow_connection_usage,
+        $.metadata_definition,
+        $.metadata_usage,
+        $.verification_definition,
+        $.verification_usage,
+        $.analysis_case_definition,
+        $.use_case_definition,
+        $.use_case_usage,
+        $.satisfy_requirement_usage,
+        $.allocation_usage,
+        $.occurrence_definition,
+        $.occurrence_usage,
+        $.portion_usage,
+        $.view_definition,
+        $.viewpoint_definition,
+        $.rendering_definition,
         $.definition,
         $.usage
       ),
 
+    // Bodies are flat repeats of members, so error recovery can discard a
+    // malformed member and resume at the next one. Keep new bodies this way.
     block: ($) => seq("{", repeat($._statement), "}"),
 
+    // `library package` and `standard library package` declare model
+    // libraries; `standard` is reserved for the libraries that ship with
+    // SysML itself.
     package_decl: ($) =>
-      seq("package", field("name", $.identifier), optional($.block)),
+      seq(
+        optional(field("visibility", $.visibility)),
+        repeat($.annotation),
+        optional(
+          seq(
+            optional(field("standard", alias("standard", $.standard_modifier))),
+            field("library", alias("library", $.library_modifier))
+          )
+        ),
+        "package",
+        field("name", $.identifier),
+        optional($.block),
+        optional(";")
+      ),
+
+    // `::*` and `::**` are single tokens so that one token of lookahead is
+    // enough to tell them apart from another `::` segment.
+    import_statement: ($) =>
+      seq(
+        optional(field("visibility", $.visibility)),
+        "import",
+        optional("all"),
+        field("name", $.qualified_name),
+        optional($._import_kind),
+        repeat($.import_filter),
+        ";"
+      ),
+
+    // A body after the target may only hold documentation of the alias.
+    alias_member: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          "alias",
+          field("alias_name", $.identifier),
+          "for",
+          field("target", $.qualified_name),
+          choice(seq("{", repeat($.documentation), "}"), ";")
+        )
+      ),
+
+    // `::*` imports the members of a namespace and `::**` every element
+    // nested in it; a name without either imports that one element.
+    _import_kind: ($) =>
+      choice(
+        field("wildcard", alias("::*", $.import_wildcard)),
+        field("recursive", alias("::**", $.import_recursive))
+      ),
 
-    import_decl: ($) =>
-      seq("import", field("path", $.import_path), ";"),
+    // `[@Safety]` keeps the elements annotated with that metadata; any other
+    // filter is a boolean expression.
+    import_filter: ($) =>
+      seq(
+        "[",
+        choice(
+          field("condition", $._expression),
+          seq("@", field("metadata", $.qualified_name))
+        ),
+        "]"
+      ),
 
-    import_path: ($) => /[^;]+/,
+    visibility: ($) => choice("public", "private", "protected"),
 
     part_def: ($) =>
       prec(
         2,
         seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
           "part",
           "def",
           field("name", $.identifier),
-          optional($.typing),
+          optional($._relationships),
           optional($.block),
           optional(";")
         )
@@ -59,9 +194,13 @@ module.exports = grammar({
       prec(
         1,
         seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
           "part",
           field("name", $.identifier),
-          optional($.typing),
+          optional($._relationships),
+          optional($._multiplicity_part),
           optional($.block),
           optional(";")
         )
@@ -71,10 +210,13 @@ module.exports = grammar({
       prec(
         2,
         seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
           "attribute",
           "def",
           field("name", $.identifier),
-          optional($.typing),
+          optional($._relationships),
           optional(";")
         )
       ),
@@ -83,30 +225,199 @@ module.exports = grammar({
       prec(
         1,
         seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
           "attribute",
           field("name", $.identifier),
-          optional($.typing),
+          optional($._relationships),
+          optional($._multiplicity_part),
+          // A unit can only follow a value; `[` straight after the typing
+          // is always a multiplicity.
+          optional(
+            seq(
+              $._feature_value,
+              optional(seq("[", field("unit", $._expression), "]"))
+            )
+          ),
           optional(";")
         )
       ),
 
-    definition: ($) =>
+    port_definition: ($) =>
       prec(
         2,
         seq(
-          choice(
-            "action",
-            "state",
-            "interface",
-            "port",
-            "requirement",
-            "constraint",
-            "enum",
-            "type"
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "port",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.port_body),
+          optional(";")
+        )
+      ),
+
+    // A conjugated port, typed as `: ~P`, reverses every direction of P.
+    port_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "port",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($._multiplicity_part),
+          optional($.port_body),
+          optional(";")
+        )
+      ),
+
+    port_body: ($) =>
+      seq("{", repeat(choice($._statement, $.directed_feature)), "}"),
+
+    directed_feature: ($) =>
+      seq(
+        field("direction", $.direction),
+        optional(choice("attribute", "item")),
+        field("name", $.identifier),
+        optional($._relationships),
+        optional($._multiplicity_part),
+        ";"
+      ),
+
+    // Whether a port feature or a parameter flows in, out or both ways.
+    direction: ($) => choice(...enums.FeatureDirectionKind),
+
+    item_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "item",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.block),
+          optional(";")
+        )
+      ),
+
+    item_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "item",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($._multiplicity_part),
+          optional($.block),
+          optional(";")
+        )
+      ),
+
+    // `flow of Fuel from tank.out to engine.in;` conveys Fuel items from
+    // the source feature to the target. `item flow` is the older spelling.
+    flow_connection_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          optional("item"),
+          "flow",
+          optional(field("name", $.identifier)),
+          optional($._relationships),
+          optional(seq("of", field("item", $.qualified_name))),
+          optional(
+            seq(
+              "from",
+              field("source", $._connector_end),
+              "to",
+              field("target", $._connector_end)
+            )
           ),
+          choice($.connection_body, ";")
+        )
+      ),
+
+    metadata_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "metadata",
           "def",
           field("name", $.identifier),
-          optional($.typing),
+          optional($._relationships),
+          optional($.metadata_body),
+          optional(";")
+        )
+      ),
+
+    // `metadata m : Safety about brake, clutch;` annotates the listed
+    // elements; without `about` it annotates its owner. The type is
+    // required and the name is not, so `metadata Safety;` is unnamed.
+    metadata_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "metadata",
+          optional(seq(field("name", $.identifier), ":")),
+          field("type", $.qualified_name),
+          optional(
+            seq(
+              "about",
+              field("about", $.qualified_name),
+              repeat(seq(",", field("about", $.qualified_name)))
+            )
+          ),
+          choice($.metadata_body, ";")
+        )
+      ),
+
+    // `@Safety { isMandatory = true; }` is shorthand for a metadata usage
+    // typed by Safety that annotates the member it precedes.
+    annotation: ($) =>
+      seq("@", field("type", $.qualified_name), optional($.metadata_body)),
+
+    metadata_body: ($) =>
+      seq("{", repeat(choice($._statement, $.metadata_assignment)), "}"),
+
+    metadata_assignment: ($) =>
+      seq(
+        field("name", $.identifier),
+        "=",
+        field("value", $._expression),
+        ";"
+      ),
+
+    definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "type",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
           optional($.block),
           optional(";")
         )
@@ -116,39 +427,1093 @@ module.exports = grammar({
       prec(
         1,
         seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "type",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($._multiplicity_part),
+          optional($.block),
+          optional(";")
+        )
+      ),
+
+    // An `individual` occurrence definition has exactly one instance, and an
+    // individual usage stands for that instance.
+    occurrence_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          optional(field("individual", "individual")),
+          "occurrence",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.block),
+          optional(";")
+        )
+      ),
+
+    occurrence_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          optional(field("individual", "individual")),
+          "occurrence",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($._multiplicity_part),
+          optional($.block),
+          optional(";")
+        )
+      ),
+
+    // A snapshot is the occurrence at a single instant of its lifetime; a
+    // timeslice covers a period of it.
+    portion_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          field("portion", choice("snapshot", "timeslice")),
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($._multiplicity_part),
+          optional($.block),
+          optional(";")
+        )
+      ),
+
+    requirement_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "requirement",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.requirement_body),
+          optional(";")
+        )
+      ),
+
+    requirement_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "requirement",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.requirement_body),
+          optional(";")
+        )
+      ),
+
+    requirement_body: ($) =>
+      seq(
+        "{",
+        repeat(
+          choice(
+            $._statement,
+            $.subject_member,
+            $.require_constraint_member,
+            $.verify_member
+          )
+        ),
+        "}"
+      ),
+
+    subject_member: ($) =>
+      seq("subject", field("name", $.identifier), optional($.typing), ";"),
+
+    require_constraint_member: ($) =>
+      prec(
+        2,
+        seq(
+          field("kind", choice("assume", "require")),
+          "constraint",
+          optional(field("name", $.identifier)),
+          optional($.typing),
+          choice($.constraint_body, ";")
+        )
+      ),
+
+    constraint_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "constraint",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.constraint_body),
+          optional(";")
+        )
+      ),
+
+    constraint_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "constraint",
+          optional(field("name", $.identifier)),
+          optional($._relationships),
+          choice($.constraint_body, ";")
+        )
+      ),
+
+    // `assert constraint c { ... }` declares a constraint that must hold, and
+    // `assert c;` asserts an existing one; `assume` makes either an
+    // assumption instead, and `not` negates it. Inside a requirement body
+    // `assume constraint` is the requirement's own assumption.
+    assert_constraint_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          field("kind", choice("assert", "assume")),
+          optional(field("negated", "not")),
+          choice(
+            seq(
+              "constraint",
+              optional(field("name", $.identifier)),
+              optional($._relationships),
+              choice($.constraint_body, ";")
+            ),
+            seq(
+              field("constraint", $.qualified_name),
+              optional($._relationships),
+              ";"
+            )
+          )
+        )
+      ),
+
+    // The body is a single boolean expression, after any parameters; it is
+    // not terminated by `;`.
+    constraint_body: ($) =>
+      seq(
+        "{",
+        repeat(choice($.documentation, $.parameter_member)),
+        optional(field("expression", $._expression)),
+        "}"
+      ),
+
+    state_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "state",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.state_body),
+          optional(";")
+        )
+      ),
+
+    // Like action_usage with `perform`, for the states an owner exhibits.
+    // The modifier is an aliased keyword rather than a rule of its own, so
+    // error recovery cannot reduce a stray `name;` to a state_usage whose
+    // keyword is missing.
+    state_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
           choice(
-            "action",
-            "state",
-            "interface",
-            "port",
-            "requirement",
-            "constraint",
-            "enum",
-            "type"
+            seq(
+              optional(alias("exhibit", $.exhibit_modifier)),
+              "state",
+              field("name", $.identifier),
+              optional($._relationships)
+            ),
+            seq(
+              alias("exhibit", $.exhibit_modifier),
+              field("target", $.qualified_name)
+            )
+          ),
+          optional($.state_body),
+          optional(";")
+        )
+      ),
+
+    state_body: ($) =>
+      seq(
+        "{",
+        repeat(
+          choice($._statement, $.state_action_member, $.transition_usage)
+        ),
+        "}"
+      ),
+
+    state_action_member: ($) =>
+      seq(
+        field("kind", choice("entry", "do", "exit")),
+        optional("action"),
+        optional(field("name", $.identifier)),
+        optional($.typing),
+        choice($.block, ";")
+      ),
+
+    transition_usage: ($) =>
+      seq(
+        choice(
+          seq(
+            "transition",
+            optional(field("name", $.identifier)),
+            optional($._transition_source)
           ),
+          $._transition_source,
+          $._transition_trigger
+        ),
+        optional($._transition_trigger),
+        optional(seq("if", field("guard", $._expression))),
+        optional(
+          seq("do", optional("action"), field("effect", $.qualified_name))
+        ),
+        "then",
+        field("target", $.qualified_name),
+        ";"
+      ),
+
+    _transition_source: ($) => seq("first", field("source", $.qualified_name)),
+
+    _transition_trigger: ($) =>
+      seq("accept", field("trigger", $.qualified_name)),
+
+    action_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "action",
+          "def",
           field("name", $.identifier),
-          optional($.typing),
+          optional($._relationships),
+          optional($.action_body),
+          optional(";")
+        )
+      ),
+
+    // `perform action a;` declares an action the owner carries out, and
+    // `perform a;` names one declared elsewhere.
+    action_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          choice(
+            seq(
+              optional(alias("perform", $.perform_modifier)),
+              "action",
+              field("name", $.identifier),
+              optional($._relationships),
+              optional($._multiplicity_part)
+            ),
+            seq(
+              alias("perform", $.perform_modifier),
+              field("target", $.qualified_name)
+            )
+          ),
+          optional($.action_body),
+          optional(";")
+        )
+      ),
+
+    action_body: ($) =>
+      seq(
+        "{",
+        repeat(
+          choice(
+            $._statement,
+            $.parameter_member,
+            $.succession,
+            $.control_node
+          )
+        ),
+        "}"
+      ),
+
+    // `first a;` marks the start, `first a then b;` links two steps and
+    // `then ...;` continues from the previous member. A `then` target may be
+    // declared inline as an action or control node.
+    succession: ($) =>
+      choice(
+        seq(
+          "first",
+          field("source", $.qualified_name),
+          optional(
+            seq(
+              optional($._succession_guard),
+              "then",
+              field("target", $.qualified_name)
+            )
+          ),
+          ";"
+        ),
+        seq(
+          optional($._succession_guard),
+          "then",
+          choice(
+            seq(field("target", $.qualified_name), ";"),
+            field("target", choice($.action_usage, $.control_node))
+          )
+        ),
+        seq("else", field("target", $.qualified_name), ";")
+      ),
+
+    _succession_guard: ($) => seq("if", field("guard", $._expression)),
+
+    control_node: ($) =>
+      seq(
+        field("kind", choice("fork", "join", "merge", "decide")),
+        optional(field("name", $.identifier)),
+        ";"
+      ),
+
+    enumeration_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "enum",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.enumeration_body),
+          optional(";")
+        )
+      ),
+
+    enumeration_body: ($) =>
+      seq("{", repeat(choice($.documentation, $.enumeration_literal)), "}"),
+
+    enumeration_literal: ($) =>
+      seq(
+        optional("enum"),
+        field("name", $.identifier),
+        optional(seq("=", field("value", $._expression))),
+        ";"
+      ),
+
+    calc_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "calc",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.calc_body),
+          optional(";")
+        )
+      ),
+
+    calc_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "calc",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.calc_body),
+          optional(";")
+        )
+      ),
+
+    calc_body: ($) =>
+      seq(
+        "{",
+        repeat(choice($._statement, $.parameter_member, $.return_member)),
+        optional(field("result", $._expression)),
+        "}"
+      ),
+
+    parameter_member: ($) =>
+      seq(
+        field("direction", $.direction),
+        field("name", $.identifier),
+        optional($.typing),
+        optional($._multiplicity_part),
+        optional($._feature_value),
+        ";"
+      ),
+
+    return_member: ($) =>
+      seq(
+        "return",
+        optional(field("name", $.identifier)),
+        optional($.typing),
+        optional($._feature_value),
+        ";"
+      ),
+
+    verification_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "verification",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.case_body),
+          optional(";")
+        )
+      ),
+
+    verification_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "verification",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.case_body),
+          optional(";")
+        )
+      ),
+
+    analysis_case_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "analysis",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.case_body),
+          optional(";")
+        )
+      ),
+
+    use_case_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "use",
+          "case",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.case_body),
+          optional(";")
+        )
+      ),
+
+    use_case_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "use",
+          "case",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.case_body),
+          optional(";")
+        )
+      ),
+
+    // `include use case u;` declares a use case performed as part of the
+    // enclosing one, and `include u;` names one declared elsewhere.
+    include_use_case: ($) =>
+      seq(
+        "include",
+        choice(
+          seq(
+            "use",
+            "case",
+            field("name", $.identifier),
+            optional($._relationships),
+            optional($._multiplicity_part)
+          ),
+          field("target", $.qualified_name)
+        ),
+        choice($.case_body, ";")
+      ),
+
+    actor_member: ($) =>
+      seq(
+        "actor",
+        field("name", $.identifier),
+        optional($.typing),
+        optional($._multiplicity_part),
+        ";"
+      ),
+
+    // Verification, analysis and use cases are calculations, so like
+    // calc_body they may end with a result expression.
+    case_body: ($) =>
+      seq(
+        "{",
+        repeat(
+          choice(
+            $._statement,
+            $.subject_member,
+            $.objective_member,
+            $.actor_member,
+            $.include_use_case,
+            $.verify_member,
+            $.parameter_member,
+            $.return_member
+          )
+        ),
+        optional(field("result", $._expression)),
+        "}"
+      ),
+
+    // The objective of a case is a requirement; a verification case names
+    // the requirements it verifies with `verify` members in its body.
+    objective_member: ($) =>
+      seq(
+        "objective",
+        optional(field("name", $.identifier)),
+        optional($.typing),
+        choice($.requirement_body, ";")
+      ),
+
+    verify_member: ($) =>
+      seq(
+        "verify",
+        optional("requirement"),
+        field("requirement", $.qualified_name),
+        ";"
+      ),
+
+    connection_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "connection",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.connection_body),
+          optional(";")
+        )
+      ),
+
+    connection_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          choice(
+            seq(
+              "connection",
+              optional(field("name", $.identifier)),
+              optional($._relationships),
+              optional($._connector_part)
+            ),
+            $._connector_part
+          ),
+          choice($.connection_body, ";")
+        )
+      ),
+
+    interface_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "interface",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.connection_body),
+          optional(";")
+        )
+      ),
+
+    interface_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "interface",
+          optional(field("name", $.identifier)),
+          optional($._relationships),
+          optional($._connector_part),
+          choice($.connection_body, ";")
+        )
+      ),
+
+    connection_body: ($) =>
+      seq("{", repeat(choice($._statement, $.end_member)), "}"),
+
+    end_member: ($) =>
+      seq(
+        "end",
+        field("name", $.identifier),
+        optional($.typing),
+        optional($._multiplicity_part),
+        ";"
+      ),
+
+    _connector_part: ($) =>
+      seq(
+        "connect",
+        choice(
+          seq(
+            field("end", $._connector_end),
+            optional($.multiplicity_range),
+            "to",
+            field("end", $._connector_end),
+            optional($.multiplicity_range)
+          ),
+          seq(
+            "(",
+            field("end", $._connector_end),
+            optional($.multiplicity_range),
+            repeat1(
+              seq(
+                ",",
+                field("end", $._connector_end),
+                optional($.multiplicity_range)
+              )
+            ),
+            ")"
+          )
+        )
+      ),
+
+    binding_connector: ($) =>
+      seq(
+        "bind",
+        field("end", $._connector_end),
+        "=",
+        field("end", $._connector_end),
+        ";"
+      ),
+
+    // `satisfy R by p;` asserts that p satisfies the requirement R; without
+    // `by` the subject is the enclosing feature. A view satisfies the
+    // viewpoints it is written for with `satisfy viewpoint V;`.
+    satisfy_requirement_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "satisfy",
+          optional(choice("requirement", "viewpoint")),
+          field("satisfied", $.qualified_name),
+          optional($._relationships),
+          optional(seq("by", field("by", $._connector_end))),
+          choice($.requirement_body, ";")
+        )
+      ),
+
+    allocation_usage: ($) =>
+      prec(
+        1,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          optional(
+            seq(
+              "allocation",
+              optional(field("name", $.identifier)),
+              optional($._relationships)
+            )
+          ),
+          "allocate",
+          field("source", $._connector_end),
+          "to",
+          field("target", $._connector_end),
+          choice($.connection_body, ";")
+        )
+      ),
+
+    view_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "view",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.view_body),
+          optional(";")
+        )
+      ),
+
+    // A viewpoint is a requirement on views, so it takes a requirement body.
+    viewpoint_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "viewpoint",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
+          optional($.requirement_body),
+          optional(";")
+        )
+      ),
+
+    rendering_definition: ($) =>
+      prec(
+        2,
+        seq(
+          optional($.documentation),
+          optional(field("visibility", $.visibility)),
+          repeat($.annotation),
+          "rendering",
+          "def",
+          field("name", $.identifier),
+          optional($._relationships),
           optional($.block),
           optional(";")
         )
       ),
 
-    typing: ($) => seq(":", field("type", $.type_ref)),
+    view_body: ($) =>
+      seq(
+        "{",
+        repeat(choice($._statement, $.expose_member, $.render_member)),
+        "}"
+      ),
+
+    // Exposes elements to the view the way an import brings them into a
+    // namespace, with the same wildcards and filters.
+    expose_member: ($) =>
+      seq(
+        optional(field("visibility", $.visibility)),
+        "expose",
+        field("name", $.qualified_name),
+        optional($._import_kind),
+        repeat($.import_filter),
+        ";"
+      ),
+
+    // `render asTree;` renders the view with an existing rendering, and
+    // `render rendering r : Tree;` declares the rendering in place.
+    render_member: ($) =>
+      seq(
+        "render",
+        choice(
+          seq(
+            "rendering",
+            field("name", $.identifier),
+            optional($._relationships)
+          ),
+          field("rendering", $.qualified_name)
+        ),
+        ";"
+      ),
+
+    _connector_end: ($) =>
+      choice($.qualified_name, $.feature_chain_expression),
 
-    type_ref: ($) => choice($.qualified_name, $.identifier),
+    _expression: ($) =>
+      choice(
+        $.conditional_expression,
+        $.binary_expression,
+        $.unary_expression,
+        $.feature_chain_expression,
+        $.index_expression,
+        $.invocation_expression,
+        $.arrow_expression,
+        $.parenthesized_expression,
+        $.identifier,
+        alias($._qualified_reference, $.qualified_name),
+        $.self,
+        $._literal
+      ),
 
-    qualified_name: ($) =>
+    // A bare identifier is kept as an identifier; only names with `::`
+    // segments become a qualified_name inside expressions.
+    _qualified_reference: ($) =>
       seq($.identifier, repeat1(seq("::", $.identifier))),
 
+    // Exponentiation is right-associative; every other group is left.
+    binary_expression: ($) =>
+      choice(
+        ...binaryOperators.map((op) =>
+          (op.category === "exponentiation" ? prec.right : prec.left)(
+            op.precedence,
+            seq(
+              field("left", $._expression),
+              field("operator", choice(...op.symbols)),
+              field("right", $._expression)
+            )
+          )
+        )
+      ),
+
+    unary_expression: ($) =>
+      prec(
+        PREC.unary,
+        seq(
+          field("operator", choice(...unarySymbols)),
+          field("operand", $._expression)
+        )
+      ),
+
+    // KerML writes `if c ? a else b`; `if c then a else b` is accepted too.
+    conditional_expression: ($) =>
+      prec.right(
+        PREC.conditional,
+        seq(
+          "if",
+          field("condition", $._expression),
+          choice("?", "then"),
+          field("then", $._expression),
+          "else",
+          field("else", $._expression)
+        )
+      ),
+
+    // `engine.fuelPort.pressure` nests to the left, so the object of the
+    // outermost access is the chain `engine.fuelPort`.
+    feature_chain_expression: ($) =>
+      prec.left(
+        PREC.call,
+        seq(field("object", $._expression), ".", field("member", $.identifier))
+      ),
+
+    // KerML indexes a sequence with `#(i)`; a bare `[` after a value is
+    // taken by its unit.
+    index_expression: ($) =>
+      prec(
+        PREC.call,
+        seq(
+          field("object", $._expression),
+          "#",
+          "(",
+          field("index", $._expression),
+          ")"
+        )
+      ),
+
+    self: ($) => "self",
+
+    invocation_expression: ($) =>
+      prec(
+        PREC.call,
+        seq(
+          field("function", $._expression),
+          field("arguments", $.argument_list)
+        )
+      ),
+
+    // `wheels->forAll { in w; w.pressure > 30 }` applies a function to a
+    // collection; quantifiers are written this way in KerML.
+    arrow_expression: ($) =>
+      prec(
+        PREC.call,
+        seq(
+          field("collection", $._expression),
+          "->",
+          field("function", $.qualified_name),
+          field("arguments", choice($.argument_list, $.body_expression))
+        )
+      ),
+
+    body_expression: ($) =>
+      seq(
+        "{",
+        repeat($.parameter_member),
+        field("expression", $._expression),
+        "}"
+      ),
+
+    argument_list: ($) =>
+      seq(
+        "(",
+        optional(seq($._expression, repeat(seq(",", $._expression)))),
+        ")"
+      ),
+
+    parenthesized_expression: ($) => seq("(", $._expression, ")"),
+
+    documentation: ($) =>
+      seq(
+        "doc",
+        optional(field("name", $.identifier)),
+        field("text", $.doc_text)
+      ),
+
+    // Shares its shape with block comments; the higher precedence makes the
+    // lexer pick it right after `doc` instead of skipping it as an extra.
+    doc_text: ($) => token(prec(1, /\/\*[^*]*\*+([^/*][^*]*\*+)*\//)),
+
+    _multiplicity_part: ($) =>
+      choice(
+        seq($.multiplicity_range, repeat($.multiplicity_modifier)),
+        repeat1($.multiplicity_modifier)
+      ),
+
+    // `[n]` gives only the upper bound; `*` leaves it unbounded.
+    multiplicity_range: ($) =>
+      seq(
+        "[",
+        optional(seq(field("lower", $._multiplicity_bound), "..")),
+        field("upper", $._multiplicity_bound),
+        "]"
+      ),
+
+    _multiplicity_bound: ($) =>
+      choice($.integer_literal, $.identifier, $.unbounded),
+
+    unbounded: ($) => "*",
+
+    multiplicity_modifier: ($) => choice("ordered", "nonunique"),
+
+    // `= v` binds the feature to v for good, `:= v` only initialises it, and
+    // a `default` value applies unless a redefinition overrides it.
+    _feature_value: ($) =>
+      choice($.feature_value, $.initial_value, $.default_value),
+
+    feature_value: ($) => seq("=", field("value", $._expression)),
+
+    initial_value: ($) => seq(":=", field("value", $._expression)),
+
+    default_value: ($) =>
+      seq(
+        "default",
+        optional(field("operator", choice("=", ":="))),
+        field("value", $._expression)
+      ),
+
+    typing: ($) =>
+      seq(":", optional($.conjugation), field("type", $.qualified_name)),
+
+    conjugation: ($) => "~",
+
+    // Typing and the specialization relationships may be given in any order,
+    // as in `part engine : Engine subsets parts redefines motor`.
+    _relationships: ($) =>
+      repeat1(
+        choice(
+          $.typing,
+          $.specialization,
+          $.subsetting,
+          $.redefinition,
+          $.reference_subsetting
+        )
+      ),
+
+    specialization: ($) =>
+      seq(
+        choice("specializes", ":>"),
+        commaSep1(field("target", $.qualified_name))
+      ),
+
+    subsetting: ($) =>
+      seq("subsets", commaSep1(field("target", $.qualified_name))),
+
+    redefinition: ($) =>
+      seq(
+        choice("redefines", ":>>"),
+        commaSep1(field("target", $.qualified_name))
+      ),
+
+    reference_subsetting: ($) =>
+      seq(
+        choice("references", "::>"),
+        commaSep1(field("target", $.qualified_name))
+      ),
+
+    qualified_name: ($) => seq($.identifier, repeat(seq("::", $.identifier))),
+
     identifier: ($) => token(/[A-Za-z_][A-Za-z0-9_]*/),
 
-    literal: ($) => choice($.string, $.number, $.boolean, $.null),
+    _literal: ($) =>
+      choice(
+        $.integer_literal,
+        $.real_literal,
+        $.string_literal,
+        $.boolean_literal,
+        $.null
+      ),
+
+    // Only decimal integers exist, so `0x1F` is the integer 0 followed by
+    // the name x1F rather than a hexadecimal value.
+    integer_literal: ($) => /\d+/,
 
-    string: ($) => /"([^"\\]|\\.)*"/,
+    // A real needs digits on both sides of the point, so that `1..5` still
+    // lexes as a range.
+    real_literal: ($) =>
+      token(choice(/\d+\.\d+([eE][+-]?\d+)?/, /\d+[eE][+-]?\d+/)),
 
-    number: ($) => /\d+(\.\d+)?/,
+    // A backslash escapes the character after it, including a quote.
+    string_literal: ($) => /"([^"\\]|\\.)*"/,
 
-    boolean: ($) => choice("true", "false"),
+    boolean_literal: ($) => choice("true", "false"),
 
     null: ($) => "null",
 
@@ -158,7 +1523,11 @@ module.exports = grammar({
 
     enum_value: ($) => choice(...enumValues),
 
-    comment: ($) =>
-      token(choice(seq("//", /.*/), /\/\*[^*]*\*+([^/*][^*]*\*+)*\//)),
+    // Comments are extras, so they may appear between any two tokens and
+    // attach to no particular node. A line comment stops before the `\r` of
+    // a CRLF line break, which is whitespace like the `\n` after it.
+    line_comment: ($) => token(seq("//", /[^\r\n]*/)),
+
+    block_comment: ($) => token(/\/\*[^*]*\*+([^/*][^*]*\*+)*\//),
   },
 });
@@ -1 +1,3 @@
 ["{" "}"] @bracket
+["(" ")"] @bracket
+["[" "]"] @bracket
@@ -1 +1,23 @@
-(block) @fold
+; Fold the inside of every body so the header line stays visible.
+([
+  (block)
+  (requirement_body)
+  (constraint_body)
+  (state_body)
+  (connection_body)
+  (calc_body)
+  (case_body)
+  (view_body)
+  (action_body)
+  (enumeration_body)
+  (port_body)
+  (metadata_body)
+] @fold
+  (#offset! @fold 0 1 0 -1))
+
+; Only block comments and doc text can span several lines; single-line ranges
+; never fold.
+[
+  (block_comment)
+  (doc_text)
+] @fold
@@ -1,41 +1,244 @@
 [
   "package"
   "import"
-  "part"
-  "attribute"
-  "action"
-  "state"
-  "interface"
-  "port"
-  "requirement"
-  "constraint"
-  "enum"
-  "type"
-  "def"
+  "default"
+  "alias"
+  "for"
+  "subject"
+  "objective"
+  "actor"
+  "include"
+  "satisfy"
+  "by"
+  "allocate"
+  "expose"
+  "render"
+  "verify"
+  "assert"
+  "assume"
+  "require"
+  "entry"
+  "do"
+  "exit"
+  "transition"
+  "first"
+  "accept"
+  "if"
+  "then"
+  "specializes"
+  "subsets"
+  "redefines"
+  "references"
+  "connect"
+  "to"
+  "end"
+  "bind"
+  "doc"
+  "return"
+  "in"
+  "out"
+  "inout"
+  "else"
+  "all"
+  "fork"
+  "join"
+  "merge"
+  "decide"
 ] @keyword
 
-(comment) @comment
+; `<kind> def` introduces a definition; the bare `<kind>` introduces a usage.
+(part_def ["part" "def"] @keyword.definition)
+(attribute_def ["attribute" "def"] @keyword.definition)
+(requirement_definition ["requirement" "def"] @keyword.definition)
+(state_definition ["state" "def"] @keyword.definition)
+(action_definition ["action" "def"] @keyword.definition)
+(enumeration_definition ["enum" "def"] @keyword.definition)
+(calc_definition ["calc" "def"] @keyword.definition)
+(connection_definition ["connection" "def"] @keyword.definition)
+(interface_definition ["interface" "def"] @keyword.definition)
+(constraint_definition ["constraint" "def"] @keyword.definition)
+(port_definition ["port" "def"] @keyword.definition)
+(item_definition ["item" "def"] @keyword.definition)
+(metadata_definition ["metadata" "def"] @keyword.definition)
+(verification_definition ["verification" "def"] @keyword.definition)
+(analysis_case_definition ["analysis" "def"] @keyword.definition)
+(occurrence_definition ["occurrence" "def"] @keyword.definition)
+(use_case_definition ["use" "case" "def"] @keyword.definition)
+(view_definition ["view" "def"] @keyword.definition)
+(viewpoint_definition ["viewpoint" "def"] @keyword.definition)
+(rendering_definition ["rendering" "def"] @keyword.definition)
+(definition ["type" "def"] @keyword.definition)
+
+(part_usage "part" @keyword)
+(attribute_usage "attribute" @keyword)
+(requirement_usage "requirement" @keyword)
+(state_usage "state" @keyword)
+(action_usage "action" @keyword)
+(calc_usage "calc" @keyword)
+(connection_usage "connection" @keyword)
+(interface_usage "interface" @keyword)
+(constraint_usage "constraint" @keyword)
+(assert_constraint_usage ["constraint" "not"] @keyword)
+(port_usage "port" @keyword)
+(directed_feature ["attribute" "item"] @keyword)
+(item_usage "item" @keyword)
+(metadata_usage ["metadata" "about"] @keyword)
+(verification_usage "verification" @keyword)
+(occurrence_usage "occurrence" @keyword)
+(portion_usage portion: _ @keyword)
+(use_case_usage ["use" "case"] @keyword)
+(include_use_case ["use" "case"] @keyword)
+(satisfy_requirement_usage ["requirement" "viewpoint"] @keyword)
+(render_member "rendering" @keyword)
+(allocation_usage "allocation" @keyword)
+(flow_connection_usage ["item" "flow" "of" "from"] @keyword)
+(usage "type" @keyword)
+(enumeration_literal "enum" @keyword)
+(require_constraint_member "constraint" @keyword)
+(state_action_member "action" @keyword)
+(transition_usage "action" @keyword)
+(verify_member "requirement" @keyword)
+
+[
+  (line_comment)
+  (block_comment)
+] @comment
+(doc_text) @comment.documentation
 
 [
   "{"
   "}"
+  "("
+  ")"
+  "["
+  "]"
 ] @punctuation.bracket
 
 ["true" "false" "null"] @constant.builtin
+(unbounded) @constant.builtin
+(self) @variable.builtin
+(conjugation) @operator
+(integer_literal) @number
+(real_literal) @number.float
+(string_literal) @string
+
+(multiplicity_modifier) @keyword.modifier
+(perform_modifier) @keyword.modifier
+(exhibit_modifier) @keyword.modifier
+(multiplicity_range ".." @punctuation.delimiter)
 
 (package_decl name: (identifier) @module)
-(import_decl path: (import_path) @string.special)
+(alias_member alias_name: (identifier) @type)
+[(import_wildcard) (import_recursive)] @operator
+(import_filter "@" @attribute)
+(import_filter metadata: (qualified_name (identifier) @attribute .))
+(visibility) @keyword.modifier
+(standard_modifier) @keyword.modifier
+(library_modifier) @keyword.modifier
+(occurrence_definition individual: _ @keyword.modifier)
+(occurrence_usage individual: _ @keyword.modifier)
 
 (part_def name: (identifier) @type)
 (attribute_def name: (identifier) @type)
+(requirement_definition name: (identifier) @type)
+(state_definition name: (identifier) @type)
+(action_definition name: (identifier) @function)
+(calc_definition name: (identifier) @function)
+(enumeration_definition name: (identifier) @type)
+(constraint_definition name: (identifier) @type)
+(port_definition name: (identifier) @type)
+(item_definition name: (identifier) @type)
+(metadata_definition name: (identifier) @type)
+(verification_definition name: (identifier) @type)
+(analysis_case_definition name: (identifier) @type)
+(occurrence_definition name: (identifier) @type)
+(use_case_definition name: (identifier) @type)
+(view_definition name: (identifier) @type)
+(viewpoint_definition name: (identifier) @type)
+(rendering_definition name: (identifier) @type)
+(connection_definition name: (identifier) @type)
+(interface_definition name: (identifier) @type)
 (definition name: (identifier) @type)
 
 (part_usage name: (identifier) @variable)
 (attribute_usage name: (identifier) @property)
+(attribute_usage unit: (identifier) @type)
+(requirement_usage name: (identifier) @variable)
+(state_usage name: (identifier) @variable)
+(state_action_member name: (identifier) @function)
+(transition_usage name: (identifier) @variable)
+(action_usage name: (identifier) @function)
+(control_node name: (identifier) @label)
+(enumeration_literal name: (identifier) @constant)
+(calc_usage name: (identifier) @function)
+(parameter_member name: (identifier) @variable.parameter)
+(return_member name: (identifier) @variable.parameter)
+(connection_usage name: (identifier) @variable)
+(interface_usage name: (identifier) @variable)
+(end_member name: (identifier) @variable)
+(constraint_usage name: (identifier) @variable)
+(assert_constraint_usage name: (identifier) @variable)
+(port_usage name: (identifier) @variable)
+(directed_feature name: (identifier) @variable)
+(item_usage name: (identifier) @variable)
+(flow_connection_usage name: (identifier) @variable)
+(metadata_usage name: (identifier) @variable)
+(metadata_assignment name: (identifier) @property)
 (usage name: (identifier) @variable)
+(subject_member name: (identifier) @variable.parameter)
+(verification_usage name: (identifier) @variable)
+(objective_member name: (identifier) @variable)
+(occurrence_usage name: (identifier) @variable)
+(portion_usage name: (identifier) @variable)
+(use_case_usage name: (identifier) @variable)
+(include_use_case name: (identifier) @variable)
+(render_member name: (identifier) @variable)
+(actor_member name: (identifier) @variable.parameter)
+(allocation_usage name: (identifier) @variable)
 
-(type_ref (identifier) @type)
-(qualified_name (identifier) @type)
+; Subsetting, redefinition and reference targets are features, not types.
+(subsetting target: (qualified_name (identifier) @variable .))
+(redefinition target: (qualified_name (identifier) @variable .))
+(reference_subsetting target: (qualified_name (identifier) @variable .))
+(action_usage target: (qualified_name (identifier) @function .))
+(state_usage target: (qualified_name (identifier) @variable .))
+(include_use_case target: (qualified_name (identifier) @variable .))
+
+(arrow_expression function: (qualified_name (identifier) @function.call .))
+(annotation "@" @attribute)
+(annotation type: (qualified_name (identifier) @attribute .))
+
+; In `A::B::C` the leading segments name namespaces. The last one names a
+; type where a type is expected; other targets are captured above.
+(qualified_name (identifier) @namespace . "::")
+(typing type: (qualified_name (identifier) @type .))
+(specialization target: (qualified_name (identifier) @type .))
+(metadata_usage type: (qualified_name (identifier) @type .))
+(attribute_usage unit: (qualified_name (identifier) @type .))
+(flow_connection_usage item: (qualified_name (identifier) @type .))
 
 (typing ":" @punctuation.delimiter)
+(specialization ":>" @operator)
+(redefinition ":>>" @operator)
+(reference_subsetting "::>" @operator)
+[
+  (specialization ",")
+  (subsetting ",")
+  (redefinition ",")
+  (reference_subsetting ",")
+] @punctuation.delimiter
+(feature_value "=" @operator)
+(initial_value ":=" @operator)
+(default_value operator: _ @operator)
+(metadata_assignment "=" @operator)
+(binding_connector "=" @operator)
+(enumeration_literal "=" @operator)
 (qualified_name "::" @punctuation.delimiter)
+(feature_chain_expression "." @punctuation.delimiter)
+(index_expression "#" @operator)
+(arrow_expression "->" @operator)
+(binary_expression operator: _ @operator)
+(unary_expression operator: _ @operator)
+(conditional_expression "?" @operator)
+(invocation_expression function: (identifier) @function.call)
+(argument_list "," @punctuation.delimiter)
@@ -1,2 +1,22 @@
-((block "{") @indent)
-("}") @dedent
+; Members of a body sit one level deeper than its header, cumulatively for
+; nested bodies. Captures are given in both the Helix (@indent/@outdent) and
+; Neovim (@indent.begin/@indent.branch/@indent.end) vocabularies.
+[
+  (block)
+  (requirement_body)
+  (constraint_body)
+  (state_body)
+  (connection_body)
+  (calc_body)
+  (case_body)
+  (view_body)
+  (action_body)
+  (enumeration_body)
+  (port_body)
+  (metadata_body)
+] @indent @indent.begin
+
+; A brace that is still unclosed while typing is wrapped in an ERROR node.
+(ERROR "{") @indent @indent.begin
+
+"}" @outdent @indent.branch @indent.end
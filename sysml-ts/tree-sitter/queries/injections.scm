@@ -0,0 +1,5 @@
+; Render the body of `doc /* ... */` elements as markdown, without the
+; comment delimiters. Ordinary comments are left alone.
+((doc_text) @injection.content
+  (#offset! @injection.content 0 2 0 -2)
+  (#set! injection.language "markdown"))
@@ -1,11 +1,104 @@
+; Scopes: each body introduces a scope, so members of sibling packages or
+; definitions never resolve to each other.
 [
-  (part_def name: (identifier))
-  (attribute_def name: (identifier))
-  (definition name: (identifier))
-] @definition
+  (source_file)
+  (block)
+  (requirement_body)
+  (state_body)
+  (connection_body)
+  (calc_body)
+  (case_body)
+  (view_body)
+  (action_body)
+  (enumeration_body)
+  (port_body)
+  (metadata_body)
+  (constraint_body)
+  (body_expression)
+] @local.scope
 
-[
-  (part_usage name: (identifier))
-  (attribute_usage name: (identifier))
-  (usage name: (identifier))
-] @reference
+; Definitions
+(package_decl name: (identifier) @local.definition)
+(alias_member alias_name: (identifier) @local.definition)
+(part_def name: (identifier) @local.definition)
+(attribute_def name: (identifier) @local.definition)
+(requirement_definition name: (identifier) @local.definition)
+(state_definition name: (identifier) @local.definition)
+(action_definition name: (identifier) @local.definition)
+(calc_definition name: (identifier) @local.definition)
+(enumeration_definition name: (identifier) @local.definition)
+(constraint_definition name: (identifier) @local.definition)
+(port_definition name: (identifier) @local.definition)
+(item_definition name: (identifier) @local.definition)
+(metadata_definition name: (identifier) @local.definition)
+(verification_definition name: (identifier) @local.definition)
+(analysis_case_definition name: (identifier) @local.definition)
+(occurrence_definition name: (identifier) @local.definition)
+(use_case_definition name: (identifier) @local.definition)
+(view_definition name: (identifier) @local.definition)
+(viewpoint_definition name: (identifier) @local.definition)
+(rendering_definition name: (identifier) @local.definition)
+(enumeration_literal name: (identifier) @local.definition)
+(connection_definition name: (identifier) @local.definition)
+(interface_definition name: (identifier) @local.definition)
+(definition name: (identifier) @local.definition)
+(part_usage name: (identifier) @local.definition)
+(attribute_usage name: (identifier) @local.definition)
+(requirement_usage name: (identifier) @local.definition)
+(state_usage name: (identifier) @local.definition)
+(state_action_member name: (identifier) @local.definition)
+(transition_usage name: (identifier) @local.definition)
+(action_usage name: (identifier) @local.definition)
+(control_node name: (identifier) @local.definition)
+(calc_usage name: (identifier) @local.definition)
+(parameter_member name: (identifier) @local.definition)
+(return_member name: (identifier) @local.definition)
+(connection_usage name: (identifier) @local.definition)
+(interface_usage name: (identifier) @local.definition)
+(end_member name: (identifier) @local.definition)
+(constraint_usage name: (identifier) @local.definition)
+(assert_constraint_usage name: (identifier) @local.definition)
+(port_usage name: (identifier) @local.definition)
+(directed_feature name: (identifier) @local.definition)
+(item_usage name: (identifier) @local.definition)
+(flow_connection_usage name: (identifier) @local.definition)
+(metadata_usage name: (identifier) @local.definition)
+(usage name: (identifier) @local.definition)
+(subject_member name: (identifier) @local.definition)
+(verification_usage name: (identifier) @local.definition)
+(objective_member name: (identifier) @local.definition)
+(occurrence_usage name: (identifier) @local.definition)
+(portion_usage name: (identifier) @local.definition)
+(use_case_usage name: (identifier) @local.definition)
+(include_use_case name: (identifier) @local.definition)
+(render_member name: (identifier) @local.definition)
+(actor_member name: (identifier) @local.definition)
+(allocation_usage name: (identifier) @local.definition)
+
+; References: the first segment of a qualified name is resolved in scope,
+; as are bare identifiers used in expressions.
+(qualified_name . (identifier) @local.reference)
+(feature_chain_expression object: (identifier) @local.reference)
+(binary_expression left: (identifier) @local.reference)
+(binary_expression right: (identifier) @local.reference)
+(unary_expression operand: (identifier) @local.reference)
+(conditional_expression condition: (identifier) @local.reference)
+(conditional_expression then: (identifier) @local.reference)
+(conditional_expression else: (identifier) @local.reference)
+(parenthesized_expression (identifier) @local.reference)
+(import_filter condition: (identifier) @local.reference)
+(invocation_expression function: (identifier) @local.reference)
+(argument_list (identifier) @local.reference)
+(calc_body result: (identifier) @local.reference)
+(case_body result: (identifier) @local.reference)
+(attribute_usage unit: (identifier) @local.reference)
+(feature_value value: (identifier) @local.reference)
+(initial_value value: (identifier) @local.reference)
+(default_value value: (identifier) @local.reference)
+(enumeration_literal value: (identifier) @local.reference)
+(constraint_body expression: (identifier) @local.reference)
+(arrow_expression collection: (identifier) @local.reference)
+(body_expression expression: (identifier) @local.reference)
+(metadata_assignment value: (identifier) @local.reference)
+(transition_usage guard: (identifier) @local.reference)
+(succession guard: (identifier) @local.reference)
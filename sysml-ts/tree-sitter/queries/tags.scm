@@ -0,0 +1,17 @@
+; Every tag captures the declaring or referring node together with its bare
+; name as @name, never the whole header.
+
+(package_decl name: (identifier) @name) @definition.class
+(part_def name: (identifier) @name) @definition.class
+
+(calc_definition name: (identifier) @name) @definition.function
+(action_definition name: (identifier) @name) @definition.function
+
+(interface_definition name: (identifier) @name) @definition.interface
+(port_definition name: (identifier) @name) @definition.interface
+
+; A type is referenced by the last segment of its qualified name.
+(typing
+  type: (qualified_name (identifier) @name .)) @reference.class
+(specialization
+  target: (qualified_name (identifier) @name .)) @reference.class
@@ -0,0 +1,35 @@
+; Calculations and actions are the function-like definitions. The outer
+; object spans the whole definition, including any doc prefix and the
+; header keywords; the inner object is the body without its braces.
+[
+  (calc_definition)
+  (action_definition)
+] @function.outer
+
+(calc_definition
+  (calc_body) @function.inner
+  (#offset! @function.inner 0 1 0 -1))
+
+(action_definition
+  (action_body) @function.inner
+  (#offset! @function.inner 0 1 0 -1))
+
+; Part definitions and packages are the class-like containers.
+[
+  (part_def)
+  (package_decl)
+] @class.outer
+
+(part_def
+  (block) @class.inner
+  (#offset! @class.inner 0 1 0 -1))
+
+(package_decl
+  (block) @class.inner
+  (#offset! @class.inner 0 1 0 -1))
+
+[
+  (documentation)
+  (line_comment)
+  (block_comment)
+] @comment.outer